@@ -0,0 +1,51 @@
+// Step 1: capture the stopped source instance, with its data volumes, to Cloud Object Storage
+resource "ibm_pi_capture" "capture" {
+  provider = ibm.source
+
+  pi_cloud_instance_id                = var.source_cloud_instance_id
+  pi_instance_name                    = var.source_instance_name
+  pi_capture_name                     = var.capture_name
+  pi_capture_destination              = "cloud-storage"
+  pi_capture_volume_ids               = var.source_data_volume_ids
+  pi_capture_cloud_storage_region     = var.cos_region
+  pi_capture_cloud_storage_access_key = var.cos_access_key
+  pi_capture_cloud_storage_secret_key = var.cos_secret_key
+  pi_capture_storage_image_path       = var.cos_bucket_name
+}
+
+// Step 2: import the captured image into the target workspace
+resource "ibm_pi_image" "image" {
+  provider   = ibm.target
+  depends_on = [ibm_pi_capture.capture]
+
+  pi_cloud_instance_id      = var.target_cloud_instance_id
+  pi_image_name             = var.capture_name
+  pi_image_bucket_name      = var.cos_bucket_name
+  pi_image_bucket_access    = "private"
+  pi_image_bucket_region    = var.cos_region
+  pi_image_bucket_file_name = var.image_file_name
+  pi_image_access_key       = var.cos_access_key
+  pi_image_secret_key       = var.cos_secret_key
+  pi_image_storage_type     = var.storage_type
+}
+
+// Step 3: recreate the instance in the target workspace on the mapped networks
+resource "ibm_pi_instance" "instance" {
+  provider = ibm.target
+
+  pi_cloud_instance_id = var.target_cloud_instance_id
+  pi_instance_name     = var.target_instance_name
+  pi_memory            = var.memory
+  pi_processors        = var.processors
+  pi_proc_type         = var.proc_type
+  pi_sys_type          = var.sys_type
+  pi_image_id          = ibm_pi_image.image.image_id
+  pi_key_pair_name     = var.target_key_pair_name
+
+  dynamic "pi_network" {
+    for_each = var.target_network_ids
+    content {
+      network_id = pi_network.value
+    }
+  }
+}
@@ -0,0 +1,9 @@
+output "image_id" {
+  description = "ID of the migrated image in the target workspace"
+  value       = ibm_pi_image.image.image_id
+}
+
+output "instance_id" {
+  description = "ID of the migrated instance in the target workspace"
+  value       = ibm_pi_instance.instance.instance_id
+}
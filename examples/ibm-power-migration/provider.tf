@@ -0,0 +1,13 @@
+provider "ibm" {
+  alias            = "source"
+  ibmcloud_api_key = var.ibm_cloud_api_key // export IC_API_KEY = "<api key>"
+  region           = var.source_region
+  zone             = var.source_zone
+}
+
+provider "ibm" {
+  alias            = "target"
+  ibmcloud_api_key = var.ibm_cloud_api_key // export IC_API_KEY = "<api key>"
+  region           = var.target_region
+  zone             = var.target_zone
+}
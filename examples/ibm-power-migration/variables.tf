@@ -0,0 +1,128 @@
+// Service / Account
+variable "ibm_cloud_api_key" {
+  description = "API Key"
+  type        = string
+  default     = "<key>"
+}
+
+// Source workspace
+variable "source_region" {
+  description = "Region of the source workspace"
+  type        = string
+  default     = "<e.g dal>"
+}
+variable "source_zone" {
+  description = "Zone of the source workspace"
+  type        = string
+  default     = "<e.g dal12>"
+}
+variable "source_cloud_instance_id" {
+  description = "Cloud Instance ID of the source workspace"
+  type        = string
+  default     = "<cid>"
+}
+variable "source_instance_name" {
+  description = "Name or ID of the instance to migrate"
+  type        = string
+  default     = "<name>"
+}
+variable "source_data_volume_ids" {
+  description = "IDs of the data volumes of the instance to migrate with it"
+  type        = list(string)
+  default     = []
+}
+
+// Target workspace
+variable "target_region" {
+  description = "Region of the target workspace"
+  type        = string
+  default     = "<e.g lon>"
+}
+variable "target_zone" {
+  description = "Zone of the target workspace"
+  type        = string
+  default     = "<e.g lon04>"
+}
+variable "target_cloud_instance_id" {
+  description = "Cloud Instance ID of the target workspace"
+  type        = string
+  default     = "<cid>"
+}
+variable "target_instance_name" {
+  description = "Name of the instance in the target workspace"
+  type        = string
+  default     = "<name>"
+}
+variable "target_network_ids" {
+  description = "IDs of the target workspace networks, in the order of the source instance networks"
+  type        = list(string)
+  default     = []
+}
+variable "target_key_pair_name" {
+  description = "Name of the SSH key in the target workspace"
+  type        = string
+  default     = null
+}
+
+// Cloud Object Storage
+variable "cos_region" {
+  description = "Region of the Cloud Object Storage bucket"
+  type        = string
+  default     = "<e.g us-east>"
+}
+variable "cos_bucket_name" {
+  description = "Name of the Cloud Object Storage bucket that holds the captured image"
+  type        = string
+  default     = "<bucket>"
+}
+variable "cos_access_key" {
+  description = "HMAC access key of the Cloud Object Storage bucket"
+  type        = string
+  sensitive   = true
+  default     = "<access key>"
+}
+variable "cos_secret_key" {
+  description = "HMAC secret key of the Cloud Object Storage bucket"
+  type        = string
+  sensitive   = true
+  default     = "<secret key>"
+}
+
+// Image
+variable "capture_name" {
+  description = "Name of the captured image"
+  type        = string
+  default     = "<name>"
+}
+variable "image_file_name" {
+  description = "File name of the captured image in the bucket"
+  type        = string
+  default     = "<name>.ova.gz"
+}
+variable "storage_type" {
+  description = "Storage type of the image in the target workspace"
+  type        = string
+  default     = "tier1"
+}
+
+// Instance
+variable "memory" {
+  description = "Instance memory"
+  type        = number
+  default     = 4
+}
+variable "processors" {
+  description = "Instance processors"
+  type        = number
+  default     = 1
+}
+variable "proc_type" {
+  description = "Instance processor type"
+  type        = string
+  default     = "shared"
+}
+variable "sys_type" {
+  description = "Instance system type"
+  type        = string
+  default     = "s922"
+}
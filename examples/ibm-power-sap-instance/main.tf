@@ -0,0 +1,75 @@
+// The memory of the SAP profile sizes the volumes that are not sized explicitly
+data "ibm_pi_sap_profile" "profile" {
+  pi_cloud_instance_id = var.cloud_instance_id
+  pi_sap_profile_id    = var.sap_profile_id
+}
+
+locals {
+  memory = data.ibm_pi_sap_profile.profile.memory
+
+  // /hana/data holds at least the memory plus 10%, /hana/log half of the memory up to 512 GB,
+  // and /hana/shared the memory up to 1 TB
+  data_volume_size   = coalesce(var.data_volume_size, ceil(local.memory * 1.1 / var.data_volume_count))
+  log_volume_size    = coalesce(var.log_volume_size, ceil(min(local.memory / 2, 512) / var.log_volume_count))
+  shared_volume_size = coalesce(var.shared_volume_size, min(local.memory, 1024))
+}
+
+// The shared volume is created first, the other volumes and the boot volume of the
+// instance are placed on the same storage controller with affinity to it
+resource "ibm_pi_volume" "shared" {
+  pi_cloud_instance_id = var.cloud_instance_id
+  pi_volume_name       = "${var.instance_name}-shared"
+  pi_volume_size       = local.shared_volume_size
+  pi_volume_type       = var.volume_type
+  pi_volume_shareable  = false
+}
+
+resource "ibm_pi_volume" "data" {
+  count = var.data_volume_count
+
+  pi_cloud_instance_id = var.cloud_instance_id
+  pi_volume_name       = "${var.instance_name}-data-${count.index + 1}"
+  pi_volume_size       = local.data_volume_size
+  pi_affinity_policy   = "affinity"
+  pi_affinity_volume   = ibm_pi_volume.shared.volume_id
+  pi_volume_shareable  = false
+}
+
+resource "ibm_pi_volume" "log" {
+  count = var.log_volume_count
+
+  pi_cloud_instance_id = var.cloud_instance_id
+  pi_volume_name       = "${var.instance_name}-log-${count.index + 1}"
+  pi_volume_size       = local.log_volume_size
+  pi_affinity_policy   = "affinity"
+  pi_affinity_volume   = ibm_pi_volume.shared.volume_id
+  pi_volume_shareable  = false
+}
+
+// The instance is created with all the volumes attached, and the apply waits until
+// its health reaches health_status
+resource "ibm_pi_instance" "instance" {
+  pi_cloud_instance_id   = var.cloud_instance_id
+  pi_instance_name       = var.instance_name
+  pi_sap_profile_id      = var.sap_profile_id
+  pi_sap_deployment_type = var.sap_deployment_type
+  pi_image_id            = var.image_id
+  pi_sys_type            = var.sys_type
+  pi_key_pair_name       = var.key_pair_name
+  pi_affinity_policy     = "affinity"
+  pi_affinity_volume     = ibm_pi_volume.shared.volume_id
+  pi_health_status       = var.health_status
+
+  pi_volume_ids = concat(
+    [ibm_pi_volume.shared.volume_id],
+    ibm_pi_volume.data[*].volume_id,
+    ibm_pi_volume.log[*].volume_id,
+  )
+
+  dynamic "pi_network" {
+    for_each = var.network_ids
+    content {
+      network_id = pi_network.value
+    }
+  }
+}
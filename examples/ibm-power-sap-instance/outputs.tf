@@ -0,0 +1,23 @@
+output "instance_id" {
+  description = "ID of the SAP instance"
+  value       = ibm_pi_instance.instance.instance_id
+}
+
+output "status" {
+  description = "Status of the SAP instance"
+  value       = ibm_pi_instance.instance.status
+}
+
+output "health_status" {
+  description = "Health of the SAP instance"
+  value       = ibm_pi_instance.instance.health_status
+}
+
+output "volumes" {
+  description = "Volumes of the SAP instance by mount point, with the WWN to find each of them in the operating system"
+  value = {
+    "/hana/shared" = [for v in [ibm_pi_volume.shared] : { id = v.volume_id, name = v.pi_volume_name, size = v.pi_volume_size, wwn = v.wwn }]
+    "/hana/data"   = [for v in ibm_pi_volume.data : { id = v.volume_id, name = v.pi_volume_name, size = v.pi_volume_size, wwn = v.wwn }]
+    "/hana/log"    = [for v in ibm_pi_volume.log : { id = v.volume_id, name = v.pi_volume_name, size = v.pi_volume_size, wwn = v.wwn }]
+  }
+}
@@ -0,0 +1,5 @@
+provider "ibm" {
+  ibmcloud_api_key = var.ibm_cloud_api_key // export IC_API_KEY = "<api key>"
+  region           = var.region
+  zone             = var.zone
+}
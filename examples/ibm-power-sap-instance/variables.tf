@@ -0,0 +1,95 @@
+// Service / Account
+variable "ibm_cloud_api_key" {
+  description = "API Key"
+  type        = string
+  default     = "<key>"
+}
+variable "region" {
+  description = "Region of the workspace"
+  type        = string
+  default     = "<e.g dal>"
+}
+variable "zone" {
+  description = "Zone of the workspace"
+  type        = string
+  default     = "<e.g dal12>"
+}
+variable "cloud_instance_id" {
+  description = "Cloud Instance ID of the workspace"
+  type        = string
+  default     = "<cid>"
+}
+
+// Instance
+variable "instance_name" {
+  description = "Name of the SAP instance, the volume names start with it"
+  type        = string
+  default     = "<name>"
+}
+variable "sap_profile_id" {
+  description = "SAP profile of the instance"
+  type        = string
+  default     = "ush1-4x128"
+}
+variable "sap_deployment_type" {
+  description = "Custom SAP deployment type of the instance"
+  type        = string
+  default     = null
+}
+variable "image_id" {
+  description = "ID of the SAP certified image of the instance"
+  type        = string
+  default     = "<image id>"
+}
+variable "sys_type" {
+  description = "Instance system type"
+  type        = string
+  default     = null
+}
+variable "key_pair_name" {
+  description = "Name of the SSH key of the instance"
+  type        = string
+  default     = null
+}
+variable "network_ids" {
+  description = "IDs of the networks of the instance"
+  type        = list(string)
+  default     = []
+}
+variable "health_status" {
+  description = "Health that the instance must reach before the apply completes, OK or WARNING"
+  type        = string
+  default     = "OK"
+}
+
+// Volumes
+variable "volume_type" {
+  description = "Storage tier of the volumes"
+  type        = string
+  default     = "tier1"
+}
+variable "data_volume_count" {
+  description = "Number of /hana/data volumes"
+  type        = number
+  default     = 4
+}
+variable "data_volume_size" {
+  description = "Size of each /hana/data volume in GB, derived from the profile memory when null"
+  type        = number
+  default     = null
+}
+variable "log_volume_count" {
+  description = "Number of /hana/log volumes"
+  type        = number
+  default     = 4
+}
+variable "log_volume_size" {
+  description = "Size of each /hana/log volume in GB, derived from the profile memory when null"
+  type        = number
+  default     = null
+}
+variable "shared_volume_size" {
+  description = "Size of the /hana/shared volume in GB, derived from the profile memory when null"
+  type        = number
+  default     = null
+}
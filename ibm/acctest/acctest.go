@@ -119,6 +119,7 @@ var IksClusterID string
 var IksClusterVpcID string
 var IksClusterSubnetID string
 var IksClusterResourceGroupID string
+var CrImage string
 var IcdDbRegion string
 var IcdDbDeploymentId string
 var IcdDbBackupId string
@@ -1145,6 +1146,11 @@ func init() {
 		fmt.Println("[WARN] Set the environment variable IBM_CLUSTER_VPC_RESOURCE_GROUP_ID for testing ibm_container_vpc_alb_create resources, ibm_container_vpc_alb_creates tests will fail if this is not set")
 	}
 
+	CrImage = os.Getenv("IBM_CR_IMAGE")
+	if CrImage == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_CR_IMAGE with an image such as us.icr.io/namespace/repository:tag for testing ibm_cr_images and ibm_cr_image_manifest datasources else tests will fail if this is not set correctly")
+	}
+
 	ClusterName = os.Getenv("IBM_CONTAINER_CLUSTER_NAME")
 	if ClusterName == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_CONTAINER_CLUSTER_NAME for ibm_container_nlb_dns resource or datasource else tests will fail if this is not set correctly")
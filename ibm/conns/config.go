@@ -13,6 +13,7 @@ import (
 	"net"
 	gohttp "net/http"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -65,7 +66,10 @@ import (
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
 	searchv2 "github.com/IBM/platform-services-go-sdk/globalsearchv2"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	iamaccessgroups "github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
@@ -193,6 +197,14 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// Default Secrets Manager instance of the ibm_sm_* resources and data sources
+	SecretsManagerInstanceID   string
+	SecretsManagerRegion       string
+	SecretsManagerEndpointType string
+
+	// Default resource group of the resources that accept resource_group_id, by ID or name
+	DefaultResourceGroup string
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -211,6 +223,7 @@ type ClientSession interface {
 	BluemixAcccountAPI() (accountv2.AccountServiceAPI, error)
 	BluemixAcccountv1API() (accountv1.AccountServiceAPI, error)
 	BluemixUserDetails() (*UserConfig, error)
+	DefaultResourceGroupID() string
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
@@ -279,7 +292,10 @@ type ClientSession interface {
 	ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error)
 	CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error)
 	EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error)
+	EnterpriseBillingUnitsV1() (*enterprisebillingunitsv1.EnterpriseBillingUnitsV1, error)
+	EnterpriseUsageReportsV1() (*enterpriseusagereportsv1.EnterpriseUsageReportsV1, error)
 	ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error)
+	GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error)
 	SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error)
 	SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error)
 	SchematicsV1() (*schematicsv1.SchematicsV1, error)
@@ -317,6 +333,8 @@ type clientSession struct {
 	bmxUserDetails  *UserConfig
 	bmxUserFetchErr error
 
+	defaultResourceGroupID string
+
 	csConfigErr  error
 	csServiceAPI containerv1.ContainerServiceAPI
 
@@ -534,6 +552,12 @@ type clientSession struct {
 	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
 	enterpriseManagementClientErr error
 
+	enterpriseBillingUnitsClient    *enterprisebillingunitsv1.EnterpriseBillingUnitsV1
+	enterpriseBillingUnitsClientErr error
+
+	enterpriseUsageReportsClient    *enterpriseusagereportsv1.EnterpriseUsageReportsV1
+	enterpriseUsageReportsClientErr error
+
 	//Resource Controller Option
 	resourceControllerErr   error
 	resourceControllerAPI   *resourcecontroller.ResourceControllerV2
@@ -541,6 +565,10 @@ type clientSession struct {
 	secretsManagerClient    *secretsmanagerv2.SecretsManagerV2
 	secretsManagerClientErr error
 
+	// Global Catalog service options
+	globalCatalogAPI *globalcatalogv1.GlobalCatalogV1
+	globalCatalogErr error
+
 	// Schematics service options
 	schematicsClient    *schematicsv1.SchematicsV1
 	schematicsClientErr error
@@ -645,6 +673,12 @@ func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
+// DefaultResourceGroupID returns the ID of the default_resource_group of the provider, or an empty
+// string when it is not set
+func (sess clientSession) DefaultResourceGroupID() string {
+	return sess.defaultResourceGroupID
+}
+
 // ContainerAPI provides Container Service APIs ...
 func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
 	return sess.csServiceAPI, sess.csConfigErr
@@ -1060,11 +1094,26 @@ func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.E
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
+// Enterprise Billing Units
+func (session clientSession) EnterpriseBillingUnitsV1() (*enterprisebillingunitsv1.EnterpriseBillingUnitsV1, error) {
+	return session.enterpriseBillingUnitsClient, session.enterpriseBillingUnitsClientErr
+}
+
+// Enterprise Usage Reports
+func (session clientSession) EnterpriseUsageReportsV1() (*enterpriseusagereportsv1.EnterpriseUsageReportsV1, error) {
+	return session.enterpriseUsageReportsClient, session.enterpriseUsageReportsClientErr
+}
+
 // ResourceController Session
 func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
+// Global Catalog Session
+func (sess clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+	return sess.globalCatalogAPI, sess.globalCatalogErr
+}
+
 // IBM Cloud Secrets Manager V1 Basic API
 func (session clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
 	return session.secretsManagerClientV1, session.secretsManagerClientErr
@@ -1219,7 +1268,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerConfigErr = errEmptyBluemixCredentials
 		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
 		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
+		session.enterpriseBillingUnitsClientErr = errEmptyBluemixCredentials
+		session.enterpriseUsageReportsClientErr = errEmptyBluemixCredentials
 		session.resourceControllerErr = errEmptyBluemixCredentials
+		session.globalCatalogErr = errEmptyBluemixCredentials
 		session.catalogManagementClientErr = errEmptyBluemixCredentials
 		session.ibmpiConfigErr = errEmptyBluemixCredentials
 		session.userManagementErr = errEmptyBluemixCredentials
@@ -2878,6 +2930,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+	if c.DefaultResourceGroup != "" {
+		// resolved once, an unknown or inaccessible group fails the configuration of the provider
+		defaultResourceGroupID, err := resolveDefaultResourceGroup(resourceManagerClient, session.bmxUserDetails, c.DefaultResourceGroup)
+		if err != nil {
+			return nil, err
+		}
+		session.defaultResourceGroupID = defaultResourceGroupID
+	}
 
 	//CLOUD SHELL Service
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
@@ -2936,6 +2996,40 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
 
+	// ENTERPRISE BILLING UNITS Service
+	enterpriseBillingUnitsClientOptions := &enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_BILLING_API_ENDPOINT"}, enterprisebillingunitsv1.DefaultServiceURL),
+	}
+	enterpriseBillingUnitsClient, err := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(enterpriseBillingUnitsClientOptions)
+	if err != nil {
+		session.enterpriseBillingUnitsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Billing Units API service: %q", err)
+	}
+	if enterpriseBillingUnitsClient != nil && enterpriseBillingUnitsClient.Service != nil {
+		enterpriseBillingUnitsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enterpriseBillingUnitsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.enterpriseBillingUnitsClient = enterpriseBillingUnitsClient
+
+	// ENTERPRISE USAGE REPORTS Service
+	enterpriseUsageReportsClientOptions := &enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseusagereportsv1.DefaultServiceURL),
+	}
+	enterpriseUsageReportsClient, err := enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(enterpriseUsageReportsClientOptions)
+	if err != nil {
+		session.enterpriseUsageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Usage Reports API service: %q", err)
+	}
+	if enterpriseUsageReportsClient != nil && enterpriseUsageReportsClient.Service != nil {
+		enterpriseUsageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enterpriseUsageReportsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.enterpriseUsageReportsClient = enterpriseUsageReportsClient
+
 	// RESOURCE CONTROLLER Service
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
@@ -2972,6 +3066,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	session.resourceControllerAPI = resourceControllerClient
 
+	// GLOBAL CATALOG Service
+	globalCatalogURL := globalcatalogv1.DefaultServiceURL
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		globalCatalogURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT", c.Region, globalCatalogURL)
+	}
+	globalCatalogOptions := &globalcatalogv1.GlobalCatalogV1Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_GLOBAL_CATALOG_API_ENDPOINT"}, globalCatalogURL),
+	}
+	globalCatalogClient, err := globalcatalogv1.NewGlobalCatalogV1(globalCatalogOptions)
+	if err != nil {
+		session.globalCatalogErr = fmt.Errorf("[ERROR] Error occured while configuring Global Catalog service: %q", err)
+	}
+	if globalCatalogClient != nil && globalCatalogClient.Service != nil {
+		globalCatalogClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		globalCatalogClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.globalCatalogAPI = globalCatalogClient
+
 	// SECRETS MANAGER Service
 	secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
 		Authenticator: authenticator,
@@ -2998,10 +3113,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		smBaseUrl = ContructEndpoint(fmt.Sprintf("secrets-manager.%s", c.Region), cloudEndpoint)
 	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		smBaseUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT", c.Region, smBaseUrl)
+	}
+
+	smURL := EnvFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smBaseUrl)
+	if c.SecretsManagerInstanceID != "" {
+		// the ibm_sm_* resources take their default instance, region and endpoint type from the instance endpoint
+		smURL = secretsManagerInstanceEndpoint(c)
+	}
 
 	secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
 		Authenticator: authenticator,
-		URL:           smBaseUrl,
+		URL:           smURL,
 	}
 
 	// Construct the service client.
@@ -3491,3 +3615,59 @@ func ContructEndpoint(subdomain, domain string) string {
 	endpoint := fmt.Sprintf("https://%s.%s", subdomain, domain)
 	return endpoint
 }
+
+// secretsManagerInstanceEndpoint returns the API endpoint of the default Secrets Manager instance of the provider
+func secretsManagerInstanceEndpoint(c *Config) string {
+	region := c.SecretsManagerRegion
+	if region == "" {
+		region = c.Region
+	}
+	endpointType := c.SecretsManagerEndpointType
+	if endpointType == "" {
+		endpointType = "public"
+		if c.Visibility == "private" {
+			endpointType = "private"
+		}
+	}
+	domain := "appdomain.cloud"
+	if strings.Contains(os.Getenv("IBMCLOUD_IAM_API_ENDPOINT"), "test") {
+		domain = "test.appdomain.cloud"
+	}
+	if endpointType == "private" {
+		return fmt.Sprintf("https://%s.private.%s.secrets-manager.%s/api", c.SecretsManagerInstanceID, region, domain)
+	}
+	return fmt.Sprintf("https://%s.%s.secrets-manager.%s/api", c.SecretsManagerInstanceID, region, domain)
+}
+
+var resourceGroupIDRegexp = regexp.MustCompile("^[0-9a-f]{32}$")
+
+// resolveDefaultResourceGroup returns the ID of the resource group with the given ID or name, and
+// checks that the caller can access it
+func resolveDefaultResourceGroup(rmClient *resourcemanager.ResourceManagerV2, userDetails *UserConfig, group string) (string, error) {
+	if rmClient == nil {
+		return "", fmt.Errorf("[ERROR] Error resolving default_resource_group %s: the Resource Manager service is not configured", group)
+	}
+	if resourceGroupIDRegexp.MatchString(group) {
+		resourceGroup, resp, err := rmClient.GetResourceGroup(&resourcemanager.GetResourceGroupOptions{ID: &group})
+		if err == nil && resourceGroup != nil && resourceGroup.ID != nil {
+			return *resourceGroup.ID, nil
+		}
+		if resp == nil || (resp.StatusCode != 403 && resp.StatusCode != 404) {
+			return "", fmt.Errorf("[ERROR] Error retrieving default_resource_group %s: %s %s", group, err, resp)
+		}
+	}
+	listResourceGroupsOptions := &resourcemanager.ListResourceGroupsOptions{
+		Name: &group,
+	}
+	if userDetails != nil && userDetails.UserAccount != "" {
+		listResourceGroupsOptions.AccountID = &userDetails.UserAccount
+	}
+	resourceGroupList, resp, err := rmClient.ListResourceGroups(listResourceGroupsOptions)
+	if err != nil || resourceGroupList == nil {
+		return "", fmt.Errorf("[ERROR] Error retrieving default_resource_group %s: %s %s", group, err, resp)
+	}
+	if len(resourceGroupList.Resources) == 0 {
+		return "", fmt.Errorf("[ERROR] The default_resource_group %s could not be found. Make sure the resource group exists and you have access to it", group)
+	}
+	return *resourceGroupList.Resources[0].ID, nil
+}
@@ -47,12 +47,22 @@ func SuppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
 	return reflect.DeepEqual(oldm, newm)
 }
 
+// SecretReferenceRegexp matches a toolchain secret reference in the format {vault::integration_name.secret_name}
+var SecretReferenceRegexp = regexp.MustCompile(`^\{vault::[ a-zA-Z0-9_-]+(\.[^{}]+)?\}$`)
+
+// SecretsManagerSecretCRNRegexp matches the CRN of a Secrets Manager secret, which can be used in place of a raw secret
+var SecretsManagerSecretCRNRegexp = regexp.MustCompile(`^crn:v1:[a-z]+:(public|private):secrets-manager:[a-z0-9-]+:a/[0-9a-f]{32}:[0-9a-f-]{36}:secret:[0-9a-f-]{36}$`)
+
+// IsSecretReference reports whether value is a secret reference rather than a raw secret
+func IsSecretReference(value string) bool {
+	return SecretReferenceRegexp.MatchString(value) || SecretsManagerSecretCRNRegexp.MatchString(value)
+}
+
 func SuppressHashedRawSecret(k, old, new string, d *schema.ResourceData) bool {
 	if len(d.Id()) == 0 {
 		return false
 	}
-	isSecretRef, _ := regexp.MatchString("[{]{1}(\\b(vault)\\b[:]{2}([ a-zA-Z0-9_-]*)[.]{0,1}(.*))[}]{1}", new)
-	if isSecretRef {
+	if IsSecretReference(new) {
 		return false
 	}
 	parts, _ := SepIdParts(d.Id(), "/")
@@ -65,7 +75,7 @@ func SuppressHashedRawSecret(k, old, new string, d *schema.ResourceData) bool {
 
 func SuppressPipelinePropertyRawSecret(k, old, new string, d *schema.ResourceData) bool {
 	// ResourceIBMCdTektonPipelineProperty
-	if d.Get("type").(string) == "secure" {
+	if d.Get("type").(string) == "secure" && !IsSecretReference(new) {
 		segs := []string{d.Get("pipeline_id").(string), d.Get("name").(string)}
 		secret := strings.Join(segs, ".")
 		mac := hmac.New(sha3.New512, []byte(secret))
@@ -79,7 +89,7 @@ func SuppressPipelinePropertyRawSecret(k, old, new string, d *schema.ResourceDat
 
 func SuppressTriggerPropertyRawSecret(k, old, new string, d *schema.ResourceData) bool {
 	// ResourceIBMCdTektonPipelineTriggerProperty
-	if d.Get("type").(string) == "secure" {
+	if d.Get("type").(string) == "secure" && !IsSecretReference(new) {
 		segs := []string{d.Get("pipeline_id").(string), d.Get("trigger_id").(string), d.Get("name").(string)}
 		secret := strings.Join(segs, ".")
 		mac := hmac.New(sha3.New512, []byte(secret))
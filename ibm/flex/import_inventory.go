@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ImportInventorySchema returns the resources attribute of the import inventory data sources.
+// Each entry can be fed to an import block: to = <address>, id = <import_id>.
+func ImportInventorySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The resources found, with the address and ID to import each of them.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Terraform resource type.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "A Terraform resource name derived from the name of the resource, unique within the type.",
+				},
+				"address": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The resource address, <type>.<name>.",
+				},
+				"import_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID that the resource type accepts for import.",
+				},
+			},
+		},
+	}
+}
+
+// ImportInventory collects the entries of an import inventory data source.
+type ImportInventory struct {
+	resources []map[string]interface{}
+	names     map[string]int
+}
+
+// Add records a resource. The name is turned into a valid Terraform identifier and
+// suffixed with a counter when the type already has a resource with that name.
+func (inventory *ImportInventory) Add(resourceType, name, importID string) {
+	if inventory.names == nil {
+		inventory.names = map[string]int{}
+	}
+	base := importInventoryLabel(name)
+	label := base
+	for count := 2; inventory.names[resourceType+"."+label] > 0; count++ {
+		label = fmt.Sprintf("%s_%d", base, count)
+	}
+	inventory.names[resourceType+"."+label]++
+	inventory.resources = append(inventory.resources, map[string]interface{}{
+		"type":      resourceType,
+		"name":      label,
+		"address":   resourceType + "." + label,
+		"import_id": importID,
+	})
+}
+
+// Resources returns the collected entries, in the order they were added.
+func (inventory *ImportInventory) Resources() []map[string]interface{} {
+	if inventory.resources == nil {
+		return []map[string]interface{}{}
+	}
+	return inventory.resources
+}
+
+func importInventoryLabel(name string) string {
+	label := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		}
+		return '_'
+	}, name)
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "r_" + label
+	}
+	return label
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/sl"
 
@@ -77,6 +78,7 @@ const (
 	isLBListenerPolicyHTTPSRedirectListener   = "target_https_redirect_listener"
 	isLBPoolSessPersistenceType               = "session_persistence_type"
 	isLBPoolSessPersistenceAppCookieName      = "session_persistence_app_cookie_name"
+	isLBPoolProtocol                          = "protocol"
 	isLBProfile                               = "profile"
 	isLBRouteMode                             = "route_mode"
 	isLBType                                  = "type"
@@ -892,6 +894,69 @@ func ReplicationRuleGet(in *s3.ReplicationConfiguration) []map[string]interface{
 	return rules
 }
 
+func WebsiteConfigurationGet(in *s3.GetBucketWebsiteOutput) []map[string]interface{} {
+	websiteConfig := make([]map[string]interface{}, 0, 1)
+	if in == nil {
+		return websiteConfig
+	}
+	website := make(map[string]interface{})
+	if in.ErrorDocument != nil && in.ErrorDocument.Key != nil {
+		website["error_document"] = []map[string]interface{}{{"key": *in.ErrorDocument.Key}}
+	}
+	if in.IndexDocument != nil && in.IndexDocument.Suffix != nil {
+		website["index_document"] = []map[string]interface{}{{"suffix": *in.IndexDocument.Suffix}}
+	}
+	if in.RedirectAllRequestsTo != nil {
+		redirectAll := make(map[string]interface{})
+		if in.RedirectAllRequestsTo.HostName != nil {
+			redirectAll["host_name"] = *in.RedirectAllRequestsTo.HostName
+		}
+		if in.RedirectAllRequestsTo.Protocol != nil {
+			redirectAll["protocol"] = *in.RedirectAllRequestsTo.Protocol
+		}
+		website["redirect_all_requests_to"] = []map[string]interface{}{redirectAll}
+	}
+	if len(in.RoutingRules) > 0 {
+		routingRules := make([]map[string]interface{}, 0, len(in.RoutingRules))
+		for _, routingRule := range in.RoutingRules {
+			rule := make(map[string]interface{})
+			if routingRule.Condition != nil {
+				condition := make(map[string]interface{})
+				if routingRule.Condition.HttpErrorCodeReturnedEquals != nil {
+					condition["http_error_code_returned_equals"] = *routingRule.Condition.HttpErrorCodeReturnedEquals
+				}
+				if routingRule.Condition.KeyPrefixEquals != nil {
+					condition["key_prefix_equals"] = *routingRule.Condition.KeyPrefixEquals
+				}
+				rule["condition"] = []map[string]interface{}{condition}
+			}
+			if routingRule.Redirect != nil {
+				redirect := make(map[string]interface{})
+				if routingRule.Redirect.HostName != nil {
+					redirect["host_name"] = *routingRule.Redirect.HostName
+				}
+				if routingRule.Redirect.HttpRedirectCode != nil {
+					redirect["http_redirect_code"] = *routingRule.Redirect.HttpRedirectCode
+				}
+				if routingRule.Redirect.Protocol != nil {
+					redirect["protocol"] = *routingRule.Redirect.Protocol
+				}
+				if routingRule.Redirect.ReplaceKeyPrefixWith != nil {
+					redirect["replace_key_prefix_with"] = *routingRule.Redirect.ReplaceKeyPrefixWith
+				}
+				if routingRule.Redirect.ReplaceKeyWith != nil {
+					redirect["replace_key_with"] = *routingRule.Redirect.ReplaceKeyWith
+				}
+				rule["redirect"] = []map[string]interface{}{redirect}
+			}
+			routingRules = append(routingRules, rule)
+		}
+		website["routing_rule"] = routingRules
+	}
+	websiteConfig = append(websiteConfig, website)
+	return websiteConfig
+}
+
 func FlattenLimits(in *whisk.Limits) []interface{} {
 	att := make(map[string]interface{})
 	if in.Timeout != nil {
@@ -2405,6 +2470,15 @@ func ResourceIBMISLBPoolCookieValidate(diff *schema.ResourceDiff) error {
 	if sessionPersistenceCookieName != "" && sessionPersistenceType != "app_cookie" {
 		return fmt.Errorf("Load Balancer Pool: %s is only applicable for %s 'app_cookie'.", isLBPoolSessPersistenceAppCookieName, isLBPoolSessPersistenceType)
 	}
+
+	// cookie based session persistence needs the load balancer to read the http traffic
+	if sessionPersistenceType == "app_cookie" || sessionPersistenceType == "http_cookie" {
+		if protocol, ok := diff.GetOk(isLBPoolProtocol); ok && diff.NewValueKnown(isLBPoolProtocol) {
+			if protocol.(string) != "http" && protocol.(string) != "https" {
+				return fmt.Errorf("Load Balancer Pool: %s '%s' is only applicable for %s 'http' or 'https'", isLBPoolSessPersistenceType, sessionPersistenceType, isLBPoolProtocol)
+			}
+		}
+	}
 	return nil
 }
 
@@ -2693,9 +2767,69 @@ func GetNextIAM(next interface{}) string {
 	return q.Get("pagetoken")
 }
 
+const (
+	MaxItems = "max_items"
+	PageSize = "page_size"
+)
+
+// MaxItemsSchema returns the max_items argument shared by list data sources
+func MaxItemsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The maximum number of items to return. Listing stops as soon as this many items are collected.",
+	}
+}
+
+// PageSizeSchema returns the page_size argument shared by list data sources, bounded by the
+// maximum page size of the service API
+func PageSizeSchema(max int) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(1, max),
+		Description:  "The number of items to request per page from the API.",
+	}
+}
+
+// GetMaxItems returns the max_items argument, 0 means no limit
+func GetMaxItems(d *schema.ResourceData) int {
+	if v, ok := d.GetOk(MaxItems); ok {
+		return v.(int)
+	}
+	return 0
+}
+
+// GetPageSize returns the page_size argument as the page limit to send to the API, nil when unset
+func GetPageSize(d *schema.ResourceData) *int64 {
+	if v, ok := d.GetOk(PageSize); ok {
+		return core.Int64Ptr(int64(v.(int)))
+	}
+	return nil
+}
+
+// MaxItemsReached reports whether count items satisfy the max_items limit
+func MaxItemsReached(maxItems, count int) bool {
+	return maxItems > 0 && count >= maxItems
+}
+
+// MaxItemsLen returns the number of the count collected items to keep under the max_items limit
+func MaxItemsLen(maxItems, count int) int {
+	if maxItems > 0 && count > maxItems {
+		return maxItems
+	}
+	return count
+}
+
 /* Return the default resource group */
 func DefaultResourceGroup(meta interface{}) (string, error) {
 
+	// the default_resource_group of the provider takes precedence over the default group of the account
+	if defaultRg := meta.(conns.ClientSession).DefaultResourceGroupID(); defaultRg != "" {
+		return defaultRg, nil
+	}
+
 	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
 	if err != nil {
 		return "", err
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testMaxItemsResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		MaxItems: MaxItemsSchema(),
+		PageSize: PageSizeSchema(100),
+	}, raw)
+}
+
+// testMaxItemsList collects total items in pages of pageSize the way the list data sources do,
+// and returns the number of pages read and of items kept
+func testMaxItemsList(maxItems, pageSize, total int) (pages, count int) {
+	for {
+		pages++
+		count += pageSize
+		if count >= total {
+			count = total
+			break
+		}
+		if MaxItemsReached(maxItems, count) {
+			break
+		}
+	}
+	return pages, MaxItemsLen(maxItems, count)
+}
+
+func TestGetMaxItems(t *testing.T) {
+	if maxItems := GetMaxItems(testMaxItemsResourceData(t, map[string]interface{}{})); maxItems != 0 {
+		t.Fatalf("expected no limit when max_items is not set, got %d", maxItems)
+	}
+	if maxItems := GetMaxItems(testMaxItemsResourceData(t, map[string]interface{}{MaxItems: 3})); maxItems != 3 {
+		t.Fatalf("expected 3, got %d", maxItems)
+	}
+	if pageSize := GetPageSize(testMaxItemsResourceData(t, map[string]interface{}{})); pageSize != nil {
+		t.Fatalf("expected no page limit when page_size is not set, got %d", *pageSize)
+	}
+	if pageSize := GetPageSize(testMaxItemsResourceData(t, map[string]interface{}{PageSize: 50})); pageSize == nil || *pageSize != 50 {
+		t.Fatalf("expected a page limit of 50, got %v", pageSize)
+	}
+}
+
+func TestMaxItemsLen(t *testing.T) {
+	cases := []struct {
+		name     string
+		maxItems int
+		count    int
+		expected int
+	}{
+		{"no limit", 0, 250, 250},
+		{"no items", 0, 0, 0},
+		{"smaller than one page", 3, 100, 3},
+		{"equal to the count", 100, 100, 100},
+		{"larger than the result set", 1000, 250, 250},
+	}
+	for _, c := range cases {
+		if got := MaxItemsLen(c.maxItems, c.count); got != c.expected {
+			t.Errorf("%s: MaxItemsLen(%d, %d) = %d, expected %d", c.name, c.maxItems, c.count, got, c.expected)
+		}
+	}
+}
+
+func TestMaxItemsReached(t *testing.T) {
+	cases := []struct {
+		name     string
+		maxItems int
+		pageSize int
+		total    int
+		pages    int
+		count    int
+	}{
+		{"no limit", 0, 100, 250, 3, 250},
+		{"smaller than one page", 3, 100, 250, 1, 3},
+		{"equal to one page", 100, 100, 250, 1, 100},
+		{"across pages", 150, 100, 250, 2, 150},
+		{"larger than the result set", 1000, 100, 250, 3, 250},
+	}
+	for _, c := range cases {
+		pages, count := testMaxItemsList(c.maxItems, c.pageSize, c.total)
+		if pages != c.pages || count != c.count {
+			t.Errorf("%s: read %d pages and kept %d items, expected %d pages and %d items", c.name, pages, count, c.pages, c.count)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"sync"
 	"time"
@@ -56,7 +57,7 @@ import (
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -212,6 +213,38 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"default_resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID or name of the resource group used by the resources that accept resource_group_id when it is omitted",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_DEFAULT_RESOURCE_GROUP", "IBMCLOUD_DEFAULT_RESOURCE_GROUP"}, ""),
+			},
+			"sm_instance": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The default Secrets Manager instance of the ibm_sm_* resources and data sources",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the Secrets Manager instance",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The region of the Secrets Manager instance. Defaults to the region of the provider",
+						},
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+							Description:  "public or private. Defaults to private when the visibility of the provider is private, and to public otherwise",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -248,6 +281,7 @@ func Provider() *schema.Provider {
 			"ibm_appid_roles":                    appid.DataSourceIBMAppIDRoles(),
 			"ibm_appid_theme_color":              appid.DataSourceIBMAppIDThemeColor(),
 			"ibm_appid_theme_text":               appid.DataSourceIBMAppIDThemeText(),
+			"ibm_appid_tenant_inventory":         appid.DataSourceIBMAppIDTenantInventory(),
 			"ibm_appid_user_roles":               appid.DataSourceIBMAppIDUserRoles(),
 
 			"ibm_function_action":                   functions.DataSourceIBMFunctionAction(),
@@ -323,6 +357,8 @@ func Provider() *schema.Provider {
 			"ibm_container_dedicated_host_flavors":  kubernetes.DataSourceIBMContainerDedicatedHostFlavors(),
 			"ibm_container_dedicated_host":          kubernetes.DataSourceIBMContainerDedicatedHost(),
 			"ibm_cr_namespaces":                     registry.DataIBMContainerRegistryNamespaces(),
+			"ibm_cr_images":                         registry.DataIBMContainerRegistryImages(),
+			"ibm_cr_image_manifest":                 registry.DataIBMContainerRegistryImageManifest(),
 			"ibm_cloud_shell_account_settings":      cloudshell.DataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                        cos.DataSourceIBMCosBucket(),
 			"ibm_cos_bucket_object":                 cos.DataSourceIBMCosBucketObject(),
@@ -331,6 +367,7 @@ func Provider() *schema.Provider {
 			"ibm_dns_secondary":                     classicinfrastructure.DataSourceIBMDNSSecondary(),
 			"ibm_event_streams_topic":               eventstreams.DataSourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":              eventstreams.DataSourceIBMEventStreamsSchema(),
+			"ibm_event_streams_consumer_groups":     eventstreams.DataSourceIBMEventStreamsConsumerGroups(),
 			"ibm_hpcs":                              hpcs.DataSourceIBMHPCS(),
 			"ibm_hpcs_managed_key":                  hpcs.DataSourceIbmManagedKey(),
 			"ibm_hpcs_key_template":                 hpcs.DataSourceIbmKeyTemplate(),
@@ -345,6 +382,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_roles":                         iampolicy.DataSourceIBMIAMRole(),
 			"ibm_iam_user_policy":                   iampolicy.DataSourceIBMIAMUserPolicy(),
 			"ibm_iam_authorization_policies":        iampolicy.DataSourceIBMIAMAuthorizationPolicies(),
+			"ibm_iam_account_policies":              iampolicy.DataSourceIBMIAMAccountPolicies(),
 			"ibm_iam_user_profile":                  iamidentity.DataSourceIBMIAMUserProfile(),
 			"ibm_iam_service_id":                    iamidentity.DataSourceIBMIAMServiceID(),
 			"ibm_iam_service_policy":                iampolicy.DataSourceIBMIAMServicePolicy(),
@@ -355,15 +393,17 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_claim_rules":   iamidentity.DataSourceIBMIamTrustedProfileClaimRules(),
 			"ibm_iam_trusted_profile_links":         iamidentity.DataSourceIBMIamTrustedProfileLinks(),
 			"ibm_iam_trusted_profiles":              iamidentity.DataSourceIBMIamTrustedProfiles(),
+			"ibm_iam_import_inventory":              iamidentity.DataSourceIBMIamImportInventory(),
 			"ibm_iam_trusted_profile_policy":        iampolicy.DataSourceIBMIAMTrustedProfilePolicy(),
 
 			//backup as Service
-			"ibm_is_backup_policy":       vpc.DataSourceIBMIsBackupPolicy(),
-			"ibm_is_backup_policies":     vpc.DataSourceIBMIsBackupPolicies(),
-			"ibm_is_backup_policy_plan":  vpc.DataSourceIBMIsBackupPolicyPlan(),
-			"ibm_is_backup_policy_plans": vpc.DataSourceIBMIsBackupPolicyPlans(),
-			"ibm_is_backup_policy_job":   vpc.DataSourceIBMIsBackupPolicyJob(),
-			"ibm_is_backup_policy_jobs":  vpc.DataSourceIBMIsBackupPolicyJobs(),
+			"ibm_is_backup_policy":          vpc.DataSourceIBMIsBackupPolicy(),
+			"ibm_is_backup_policies":        vpc.DataSourceIBMIsBackupPolicies(),
+			"ibm_is_backup_policy_plan":     vpc.DataSourceIBMIsBackupPolicyPlan(),
+			"ibm_is_backup_policy_plans":    vpc.DataSourceIBMIsBackupPolicyPlans(),
+			"ibm_is_backup_policy_job":      vpc.DataSourceIBMIsBackupPolicyJob(),
+			"ibm_is_backup_policy_jobs":     vpc.DataSourceIBMIsBackupPolicyJobs(),
+			"ibm_is_backup_policy_snapshot": vpc.DataSourceIBMIsBackupPolicySnapshot(),
 
 			// bare_metal_server
 			"ibm_is_bare_metal_server_disk":                           vpc.DataSourceIBMIsBareMetalServerDisk(),
@@ -390,6 +430,7 @@ func Provider() *schema.Provider {
 			"ibm_is_dedicated_host_disks":            vpc.DataSourceIbmIsDedicatedHostDisks(),
 			"ibm_is_placement_group":                 vpc.DataSourceIbmIsPlacementGroup(),
 			"ibm_is_placement_groups":                vpc.DataSourceIbmIsPlacementGroups(),
+			"ibm_is_placement_group_members":         vpc.DataSourceIbmIsPlacementGroupMembers(),
 			"ibm_is_floating_ip":                     vpc.DataSourceIBMISFloatingIP(),
 			"ibm_is_floating_ips":                    vpc.DataSourceIBMIsFloatingIps(),
 			"ibm_is_flow_log":                        vpc.DataSourceIBMIsFlowLog(),
@@ -412,6 +453,7 @@ func Provider() *schema.Provider {
 			"ibm_is_virtual_endpoint_gateway":        vpc.DataSourceIBMISEndpointGateway(),
 			"ibm_is_instance_template":               vpc.DataSourceIBMISInstanceTemplate(),
 			"ibm_is_instance_templates":              vpc.DataSourceIBMISInstanceTemplates(),
+			"ibm_is_instance_template_from_instance": vpc.DataSourceIBMIsInstanceTemplateFromInstance(),
 			"ibm_is_instance_profile":                vpc.DataSourceIBMISInstanceProfile(),
 			"ibm_is_instance_profiles":               vpc.DataSourceIBMISInstanceProfiles(),
 			"ibm_is_instance":                        vpc.DataSourceIBMISInstance(),
@@ -446,10 +488,12 @@ func Provider() *schema.Provider {
 			"ibm_is_lbs":                             vpc.DataSourceIBMISLBS(),
 			"ibm_is_public_gateway":                  vpc.DataSourceIBMISPublicGateway(),
 			"ibm_is_public_gateways":                 vpc.DataSourceIBMISPublicGateways(),
+			"ibm_is_public_gateway_subnets":          vpc.DataSourceIBMISPublicGatewaySubnets(),
 			"ibm_is_region":                          vpc.DataSourceIBMISRegion(),
 			"ibm_is_regions":                         vpc.DataSourceIBMISRegions(),
 			"ibm_is_ssh_key":                         vpc.DataSourceIBMISSSHKey(),
 			"ibm_is_ssh_keys":                        vpc.DataSourceIBMIsSshKeys(),
+			"ibm_is_import_inventory":                vpc.DataSourceIBMIsImportInventory(),
 			"ibm_is_subnet":                          vpc.DataSourceIBMISSubnet(),
 			"ibm_is_subnets":                         vpc.DataSourceIBMISSubnets(),
 			"ibm_is_subnet_reserved_ip":              vpc.DataSourceIBMISReservedIP(),
@@ -474,6 +518,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_gateways":                    vpc.DataSourceIBMISVPNGateways(),
 			"ibm_is_vpc_address_prefixes":            vpc.DataSourceIbmIsVpcAddressPrefixes(),
 			"ibm_is_vpc_address_prefix":              vpc.DataSourceIBMIsVPCAddressPrefix(),
+			"ibm_is_vpc_subnet_layout":               vpc.DataSourceIBMIsVpcSubnetLayout(),
 			"ibm_is_vpn_gateway_connection":          vpc.DataSourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpn_gateway_connections":         vpc.DataSourceIBMISVPNGatewayConnections(),
 			"ibm_is_vpc_default_routing_table":       vpc.DataSourceIBMISVPCDefaultRoutingTable(),
@@ -506,6 +551,7 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_policies":                   kms.DataSourceIBMKMSkeyPolicies(),
 			"ibm_kms_keys":                           kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                            kms.DataSourceIBMKMSkey(),
+			"ibm_kms_key_regions":                    kms.DataSourceIBMKMSKeyRegions(),
 			"ibm_pn_application_chrome":              pushnotification.DataSourceIBMPNApplicationChrome(),
 			"ibm_app_config_environment":             appconfiguration.DataSourceIBMAppConfigEnvironment(),
 			"ibm_app_config_environments":            appconfiguration.DataSourceIBMAppConfigEnvironments(),
@@ -520,6 +566,7 @@ func Provider() *schema.Provider {
 			"ibm_app_config_snapshot":                appconfiguration.DataSourceIBMAppConfigSnapshot(),
 			"ibm_app_config_snapshots":               appconfiguration.DataSourceIBMAppConfigSnapshots(),
 
+			"ibm_catalog_pricing":   resourcecontroller.DataSourceIBMCatalogPricing(),
 			"ibm_resource_quota":    resourcecontroller.DataSourceIBMResourceQuota(),
 			"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
 			"ibm_resource_instance": resourcecontroller.DataSourceIBMResourceInstance(),
@@ -536,6 +583,7 @@ func Provider() *schema.Provider {
 			"ibm_schematics_state":          schematics.DataSourceIBMSchematicsState(),
 			"ibm_schematics_action":         schematics.DataSourceIBMSchematicsAction(),
 			"ibm_schematics_job":            schematics.DataSourceIBMSchematicsJob(),
+			"ibm_schematics_job_log":        schematics.DataSourceIBMSchematicsJobLog(),
 			"ibm_schematics_inventory":      schematics.DataSourceIBMSchematicsInventory(),
 			"ibm_schematics_resource_query": schematics.DataSourceIBMSchematicsResourceQuery(),
 
@@ -629,6 +677,9 @@ func Provider() *schema.Provider {
 			"ibm_enterprises":               enterprise.DataSourceIBMEnterprises(),
 			"ibm_enterprise_account_groups": enterprise.DataSourceIBMEnterpriseAccountGroups(),
 			"ibm_enterprise_accounts":       enterprise.DataSourceIBMEnterpriseAccounts(),
+			"ibm_enterprise_billing_units":  enterprise.DataSourceIBMEnterpriseBillingUnits(),
+			"ibm_enterprise_credit_pools":   enterprise.DataSourceIBMEnterpriseCreditPools(),
+			"ibm_enterprise_usage_reports":  enterprise.DataSourceIBMEnterpriseUsageReports(),
 
 			// //Added for Secrets Manager
 			// V1 data sources:
@@ -647,6 +698,7 @@ func Provider() *schema.Provider {
 			"ibm_sm_iam_credentials_configuration":                               secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmIamCredentialsConfiguration()),
 			"ibm_sm_configurations":                                              secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmConfigurations()),
 			"ibm_sm_secrets":                                                     secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecrets()),
+			"ibm_sm_import_inventory":                                            secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmImportInventory()),
 			"ibm_sm_arbitrary_secret_metadata":                                   secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmArbitrarySecretMetadata()),
 			"ibm_sm_imported_certificate_metadata":                               secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmImportedCertificateMetadata()),
 			"ibm_sm_public_certificate_metadata":                                 secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPublicCertificateMetadata()),
@@ -674,12 +726,13 @@ func Provider() *schema.Provider {
 			"ibm_satellite_cluster_worker_pool_zone_attachment": satellite.DataSourceIBMSatelliteClusterWorkerPoolAttachment(),
 
 			// // Catalog related resources
-			"ibm_cm_catalog":           catalogmanagement.DataSourceIBMCmCatalog(),
-			"ibm_cm_offering":          catalogmanagement.DataSourceIBMCmOffering(),
-			"ibm_cm_version":           catalogmanagement.DataSourceIBMCmVersion(),
-			"ibm_cm_offering_instance": catalogmanagement.DataSourceIBMCmOfferingInstance(),
-			"ibm_cm_preset":            catalogmanagement.DataSourceIBMCmPreset(),
-			"ibm_cm_object":            catalogmanagement.DataSourceIBMCmObject(),
+			"ibm_cm_catalog":                    catalogmanagement.DataSourceIBMCmCatalog(),
+			"ibm_cm_offering":                   catalogmanagement.DataSourceIBMCmOffering(),
+			"ibm_cm_version":                    catalogmanagement.DataSourceIBMCmVersion(),
+			"ibm_cm_offering_instance":          catalogmanagement.DataSourceIBMCmOfferingInstance(),
+			"ibm_cm_offering_instance_upgrades": catalogmanagement.DataSourceIBMCmOfferingInstanceUpgrades(),
+			"ibm_cm_preset":                     catalogmanagement.DataSourceIBMCmPreset(),
+			"ibm_cm_object":                     catalogmanagement.DataSourceIBMCmObject(),
 
 			// //Added for Resource Tag
 			"ibm_resource_tag": globaltagging.DataSourceIBMResourceTag(),
@@ -710,8 +763,9 @@ func Provider() *schema.Provider {
 			"ibm_scc_posture_credentials":       scc.DataSourceIBMSccPostureCredentials(),
 			"ibm_scc_posture_collectors":        scc.DataSourceIBMSccPostureCollectors(),
 			// // Added for Context Based Restrictions
-			"ibm_cbr_zone": contextbasedrestrictions.DataSourceIBMCbrZone(),
-			"ibm_cbr_rule": contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_zone":        contextbasedrestrictions.DataSourceIBMCbrZone(),
+			"ibm_cbr_rule":        contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_rule_report": contextbasedrestrictions.DataSourceIBMCbrRuleReport(),
 
 			// // Added for Event Notifications
 			"ibm_en_source":                 eventnotification.DataSourceIBMEnSource(),
@@ -827,6 +881,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_global_load_balancer":              cis.ResourceIBMCISGlb(),
 			"ibm_cis_certificate_upload":                cis.ResourceIBMCISCertificateUpload(),
 			"ibm_cis_dns_record":                        cis.ResourceIBMCISDnsRecord(),
+			"ibm_cis_dns_records":                       cis.ResourceIBMCISDnsRecords(),
 			"ibm_cis_dns_records_import":                cis.ResourceIBMCISDNSRecordsImport(),
 			"ibm_cis_rate_limit":                        cis.ResourceIBMCISRateLimit(),
 			"ibm_cis_page_rule":                         cis.ResourceIBMCISPageRule(),
@@ -850,6 +905,9 @@ func Provider() *schema.Provider {
 			"ibm_cis_firewall_rule":                     cis.ResourceIBMCISFirewallrules(),
 			"ibm_cloudant":                              cloudant.ResourceIBMCloudant(),
 			"ibm_cloudant_database":                     cloudant.ResourceIBMCloudantDatabase(),
+			"ibm_cloudant_database_index":               cloudant.ResourceIBMCloudantDatabaseIndex(),
+			"ibm_cloudant_database_security":            cloudant.ResourceIBMCloudantDatabaseSecurity(),
+			"ibm_cloudant_design_document":              cloudant.ResourceIBMCloudantDesignDocument(),
 			"ibm_cloud_shell_account_settings":          cloudshell.ResourceIBMCloudShellAccountSettings(),
 			"ibm_compute_autoscale_group":               classicinfrastructure.ResourceIBMComputeAutoScaleGroup(),
 			"ibm_compute_autoscale_policy":              classicinfrastructure.ResourceIBMComputeAutoScalePolicy(),
@@ -878,6 +936,7 @@ func Provider() *schema.Provider {
 			"ibm_container_bind_service":                kubernetes.ResourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                 kubernetes.ResourceIBMContainerWorkerPool(),
 			"ibm_container_worker_pool_zone_attachment": kubernetes.ResourceIBMContainerWorkerPoolZoneAttachment(),
+			"ibm_container_worker_pool_labels_taints":   kubernetes.ResourceIBMContainerWorkerPoolLabelsTaints(),
 			"ibm_container_storage_attachment":          kubernetes.ResourceIBMContainerVpcWorkerVolumeAttachment(),
 			"ibm_container_nlb_dns":                     kubernetes.ResourceIBMContainerNlbDns(),
 			"ibm_container_dedicated_host_pool":         kubernetes.ResourceIBMContainerDedicatedHostPool(),
@@ -888,6 +947,7 @@ func Provider() *schema.Provider {
 			"ibm_ob_monitoring":                         kubernetes.ResourceIBMObMonitoring(),
 			"ibm_cos_bucket":                            cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":           cos.ResourceIBMCOSBucketReplicationConfiguration(),
+			"ibm_cos_bucket_website_configuration":      cos.ResourceIBMCOSBucketWebsiteConfiguration(),
 			"ibm_cos_bucket_object":                     cos.ResourceIBMCOSBucketObject(),
 			"ibm_dns_domain":                            classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":   classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
@@ -895,6 +955,7 @@ func Provider() *schema.Provider {
 			"ibm_dns_record":                            classicinfrastructure.ResourceIBMDNSRecord(),
 			"ibm_event_streams_topic":                   eventstreams.ResourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":                  eventstreams.ResourceIBMEventStreamsSchema(),
+			"ibm_event_streams_consumer_group_reset":    eventstreams.ResourceIBMEventStreamsConsumerGroupReset(),
 			"ibm_firewall":                              classicinfrastructure.ResourceIBMFirewall(),
 			"ibm_firewall_policy":                       classicinfrastructure.ResourceIBMFirewallPolicy(),
 			"ibm_hpcs":                                  hpcs.ResourceIBMHPCS(),
@@ -912,6 +973,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_authorization_policy":              iampolicy.ResourceIBMIAMAuthorizationPolicy(),
 			"ibm_iam_authorization_policy_detach":       iampolicy.ResourceIBMIAMAuthorizationPolicyDetach(),
 			"ibm_iam_user_policy":                       iampolicy.ResourceIBMIAMUserPolicy(),
+			"ibm_iam_policy":                            iampolicy.ResourceIBMIAMPolicy(),
 			"ibm_iam_user_settings":                     iamidentity.ResourceIBMIAMUserSettings(),
 			"ibm_iam_service_id":                        iamidentity.ResourceIBMIAMServiceID(),
 			"ibm_iam_service_api_key":                   iamidentity.ResourceIBMIAMServiceAPIKey(),
@@ -921,6 +983,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile":                   iamidentity.ResourceIBMIAMTrustedProfile(),
 			"ibm_iam_trusted_profile_claim_rule":        iamidentity.ResourceIBMIAMTrustedProfileClaimRule(),
 			"ibm_iam_trusted_profile_link":              iamidentity.ResourceIBMIAMTrustedProfileLink(),
+			"ibm_iam_trusted_profile_links":             iamidentity.ResourceIBMIAMTrustedProfileLinks(),
 			"ibm_iam_trusted_profile_policy":            iampolicy.ResourceIBMIAMTrustedProfilePolicy(),
 			"ibm_ipsec_vpn":                             classicinfrastructure.ResourceIBMIPSecVPN(),
 
@@ -966,6 +1029,7 @@ func Provider() *schema.Provider {
 			"ibm_is_network_acl":                                 vpc.ResourceIBMISNetworkACL(),
 			"ibm_is_network_acl_rule":                            vpc.ResourceIBMISNetworkACLRule(),
 			"ibm_is_public_gateway":                              vpc.ResourceIBMISPublicGateway(),
+			"ibm_is_public_gateway_subnet_attachments":           vpc.ResourceIBMISPublicGatewaySubnetAttachments(),
 			"ibm_is_security_group":                              vpc.ResourceIBMISSecurityGroup(),
 			"ibm_is_security_group_rule":                         vpc.ResourceIBMISSecurityGroupRule(),
 			"ibm_is_security_group_target":                       vpc.ResourceIBMISSecurityGroupTarget(),
@@ -980,8 +1044,11 @@ func Provider() *schema.Provider {
 			"ibm_is_volume":                                      vpc.ResourceIBMISVolume(),
 			"ibm_is_vpn_gateway":                                 vpc.ResourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                      vpc.ResourceIBMISVPNGatewayConnection(),
+			"ibm_is_vpn_gateway_connection_routes":               vpc.ResourceIBMISVPNGatewayConnectionRoutes(),
 			"ibm_is_vpc":                                         vpc.ResourceIBMISVPC(),
 			"ibm_is_vpc_address_prefix":                          vpc.ResourceIBMISVpcAddressPrefix(),
+			"ibm_is_vpc_default_network_acl":                     vpc.ResourceIBMISVPCDefaultNetworkACL(),
+			"ibm_is_vpc_default_security_group":                  vpc.ResourceIBMISVPCDefaultSecurityGroup(),
 			"ibm_is_vpc_route":                                   vpc.ResourceIBMISVpcRoute(),
 			"ibm_is_vpc_routing_table":                           vpc.ResourceIBMISVPCRoutingTable(),
 			"ibm_is_vpc_routing_table_route":                     vpc.ResourceIBMISVPCRoutingTableRoute(),
@@ -1085,6 +1152,7 @@ func Provider() *schema.Provider {
 			"ibm_dl_gateway":            directlink.ResourceIBMDLGateway(),
 			"ibm_dl_virtual_connection": directlink.ResourceIBMDLGatewayVC(),
 			"ibm_dl_provider_gateway":   directlink.ResourceIBMDLProviderGateway(),
+			"ibm_dl_gateway_action":     directlink.ResourceIBMDLGatewayAction(),
 			"ibm_dl_route_report":       directlink.ResourceIBMDLGatewayRouteReport(),
 			// //Added for Transit Gateway
 			"ibm_tg_gateway":                  transitgateway.ResourceIBMTransitGateway(),
@@ -1117,6 +1185,7 @@ func Provider() *schema.Provider {
 			"ibm_sm_arbitrary_secret":                                            secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmArbitrarySecret()),
 			"ibm_sm_imported_certificate":                                        secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmImportedCertificate()),
 			"ibm_sm_public_certificate":                                          secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificate()),
+			"ibm_sm_public_certificate_action_validate_manual_dns":               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificateActionValidateManualDns()),
 			"ibm_sm_private_certificate":                                         secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificate()),
 			"ibm_sm_iam_credentials_secret":                                      secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmIamCredentialsSecret()),
 			"ibm_sm_username_password_secret":                                    secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmUsernamePasswordSecret()),
@@ -1141,7 +1210,9 @@ func Provider() *schema.Provider {
 			"ibm_satellite_cluster_worker_pool_zone_attachment": satellite.ResourceIbmSatelliteClusterWorkerPoolZoneAttachment(),
 
 			//Added for Resource Tag
-			"ibm_resource_tag": globaltagging.ResourceIBMResourceTag(),
+			"ibm_resource_tag":              globaltagging.ResourceIBMResourceTag(),
+			"ibm_iam_access_tag":            globaltagging.ResourceIBMIamAccessTag(),
+			"ibm_iam_access_tag_attachment": globaltagging.ResourceIBMIamAccessTagAttachment(),
 
 			// // Atracker
 			"ibm_atracker_target":   atracker.ResourceIBMAtrackerTarget(),
@@ -1227,6 +1298,9 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	addDefaultResourceGroup(provider.ResourcesMap)
+	return provider
 }
 
 var globalValidatorDict validate.ValidatorDict
@@ -1265,6 +1339,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_webhook":                 cis.ResourceIBMCISWebhooksValidator(),
 				"ibm_cis_alert":                   cis.ResourceIBMCISAlertValidator(),
 				"ibm_cis_dns_record":              cis.ResourceIBMCISDnsRecordValidator(),
+				"ibm_cis_dns_records":             cis.ResourceIBMCISDnsRecordsValidator(),
 				"ibm_cis_dns_records_import":      cis.ResourceIBMCISDnsRecordsImportValidator(),
 				"ibm_cis_edge_functions_action":   cis.ResourceIBMCISEdgeFunctionsActionValidator(),
 				"ibm_cis_edge_functions_trigger":  cis.ResourceIBMCISEdgeFunctionsTriggerValidator(),
@@ -1288,6 +1363,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_dl_virtual_connection":       directlink.ResourceIBMDLGatewayVCValidator(),
 				"ibm_dl_gateway":                  directlink.ResourceIBMDLGatewayValidator(),
 				"ibm_dl_provider_gateway":         directlink.ResourceIBMDLProviderGatewayValidator(),
+				"ibm_dl_gateway_action":           directlink.ResourceIBMDLGatewayActionValidator(),
 				"ibm_database":                    database.ResourceIBMICDValidator(),
 				"ibm_function_package":            functions.ResourceIBMFuncPackageValidator(),
 				"ibm_function_action":             functions.ResourceIBMFuncActionValidator(),
@@ -1366,6 +1442,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_resource_key":                         resourcecontroller.ResourceIBMResourceKeyValidator(),
 				"ibm_is_virtual_endpoint_gateway":          vpc.ResourceIBMISEndpointGatewayValidator(),
 				"ibm_resource_tag":                         globaltagging.ResourceIBMResourceTagValidator(),
+				"ibm_iam_access_tag":                       globaltagging.ResourceIBMIamAccessTagValidator(),
+				"ibm_iam_access_tag_attachment":            globaltagging.ResourceIBMIamAccessTagAttachmentValidator(),
 				"ibm_satellite_location":                   satellite.ResourceIBMSatelliteLocationValidator(),
 				"ibm_satellite_cluster":                    satellite.ResourceIBMSatelliteClusterValidator(),
 				"ibm_pi_volume":                            power.ResourceIBMPIVolumeValidator(),
@@ -1422,6 +1500,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_container_vpc_alb_create":              kubernetes.ResourceIBMContainerVpcAlbCreateNewValidator(),
 				"ibm_container_storage_attachment":          kubernetes.ResourceIBMContainerVpcWorkerVolumeAttachmentValidator(),
 				"ibm_container_worker_pool_zone_attachment": kubernetes.ResourceIBMContainerWorkerPoolZoneAttachmentValidator(),
+				"ibm_container_worker_pool_labels_taints":   kubernetes.ResourceIBMContainerWorkerPoolLabelsTaintsValidator(),
 				"ibm_container_bind_service":                kubernetes.ResourceIBMContainerBindServiceValidator(),
 				"ibm_container_alb_cert":                    kubernetes.ResourceIBMContainerALBCertValidator(),
 				"ibm_container_cluster_feature":             kubernetes.ResourceIBMContainerClusterFeatureValidator(),
@@ -1431,6 +1510,7 @@ func Validator() validate.ValidatorDict {
 
 				"ibm_iam_trusted_profile_claim_rule": iamidentity.ResourceIBMIAMTrustedProfileClaimRuleValidator(),
 				"ibm_iam_trusted_profile_link":       iamidentity.ResourceIBMIAMTrustedProfileLinkValidator(),
+				"ibm_iam_trusted_profile_links":      iamidentity.ResourceIBMIAMTrustedProfileLinksValidator(),
 				"ibm_iam_service_api_key":            iamidentity.ResourceIBMIAMServiceAPIKeyValidator(),
 
 				"ibm_iam_trusted_profile_policy": iampolicy.ResourceIBMIAMTrustedProfilePolicyValidator(),
@@ -1587,8 +1667,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	var smInstanceID, smRegion, smEndpointType string
+	if v, ok := d.GetOk("sm_instance"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		smInstance := v.([]interface{})[0].(map[string]interface{})
+		smInstanceID = smInstance["instance_id"].(string)
+		smRegion = smInstance["region"].(string)
+		smEndpointType = smInstance["endpoint_type"].(string)
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
+	defaultResourceGrp := d.Get("default_resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
@@ -1623,7 +1711,55 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Visibility:           visibility,
 		EndpointsFile:        file,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+
+		SecretsManagerInstanceID:   smInstanceID,
+		SecretsManagerRegion:       smRegion,
+		SecretsManagerEndpointType: smEndpointType,
+
+		DefaultResourceGroup: defaultResourceGrp,
 	}
 
 	return config.ClientSession()
 }
+
+// addDefaultResourceGroup makes the resources with an optional and computed resource_group_id show
+// the default_resource_group of the provider in the plan when resource_group_id is omitted. The
+// schemas are not changed, so the plan of the resources is the same when the option is not set.
+func addDefaultResourceGroup(resources map[string]*schema.Resource) {
+	for _, resource := range resources {
+		rg, ok := resource.Schema["resource_group_id"]
+		if !ok || rg.Type != schema.TypeString || !rg.Optional || !rg.Computed {
+			continue
+		}
+		resource.CustomizeDiff = defaultResourceGroupCustomizeDiff(resource.CustomizeDiff)
+	}
+}
+
+// defaultResourceGroupCustomizeDiff sets the default resource group before it runs the
+// CustomizeDiff of the resource.
+func defaultResourceGroupCustomizeDiff(customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if err := setDefaultResourceGroup(diff, meta); err != nil {
+			return err
+		}
+		if customizeDiff != nil {
+			return customizeDiff(context, diff, meta)
+		}
+		return nil
+	}
+}
+
+func setDefaultResourceGroup(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || meta == nil {
+		return nil
+	}
+	defaultRg := meta.(conns.ClientSession).DefaultResourceGroupID()
+	if defaultRg == "" {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.GetAttr("resource_group_id").IsNull() {
+		return nil
+	}
+	return diff.SetNew("resource_group_id", defaultRg)
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+type testClientSession struct {
+	conns.ClientSession
+	defaultResourceGroupID string
+}
+
+func (sess testClientSession) DefaultResourceGroupID() string {
+	return sess.defaultResourceGroupID
+}
+
+func testResourceGroupResource(computed bool, customizeDiff schema.CustomizeDiffFunc) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: computed,
+				ForceNew: true,
+			},
+		},
+		CustomizeDiff: customizeDiff,
+	}
+}
+
+func testResourceGroupDiff(t *testing.T, r *schema.Resource, id string, state map[string]string, config map[string]interface{}, meta interface{}) (*terraform.InstanceDiff, error) {
+	t.Helper()
+	rawConfig := map[string]cty.Value{
+		"id":                cty.NullVal(cty.String),
+		"name":              cty.NullVal(cty.String),
+		"resource_group_id": cty.NullVal(cty.String),
+	}
+	for k, v := range config {
+		rawConfig[k] = cty.StringVal(v.(string))
+	}
+	s := &terraform.InstanceState{
+		ID:         id,
+		Attributes: state,
+		RawConfig:  cty.ObjectVal(rawConfig),
+	}
+	return r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(config), meta)
+}
+
+func TestAddDefaultResourceGroup(t *testing.T) {
+	optional := testResourceGroupResource(false, nil)
+	computed := testResourceGroupResource(true, nil)
+	addDefaultResourceGroup(map[string]*schema.Resource{
+		"optional": optional,
+		"computed": computed,
+	})
+
+	if optional.Schema["resource_group_id"].Computed {
+		t.Fatal("resource_group_id was made computed")
+	}
+	if optional.CustomizeDiff != nil {
+		t.Fatal("CustomizeDiff was added to a resource with a resource_group_id that is not computed")
+	}
+	if computed.CustomizeDiff == nil {
+		t.Fatal("CustomizeDiff was not added to a resource with a computed resource_group_id")
+	}
+}
+
+func TestAddDefaultResourceGroup_configRemoval(t *testing.T) {
+	meta := testClientSession{defaultResourceGroupID: "default"}
+	state := map[string]string{
+		"id":                "foo",
+		"name":              "foo",
+		"resource_group_id": "old",
+	}
+	config := map[string]interface{}{
+		"name": "foo",
+	}
+
+	// removing the argument of a resource that does not compute it still replaces the resource
+	optional := testResourceGroupResource(false, nil)
+	addDefaultResourceGroup(map[string]*schema.Resource{"optional": optional})
+	diff, err := testResourceGroupDiff(t, optional, "foo", state, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attr, ok := diff.Attributes["resource_group_id"]; !ok || !attr.NewRemoved || !attr.RequiresNew {
+		t.Fatalf("expected resource_group_id to be removed and to force a new resource, got %#v", diff.Attributes["resource_group_id"])
+	}
+
+	// an existing resource keeps its group instead of moving to the default
+	computed := testResourceGroupResource(true, nil)
+	addDefaultResourceGroup(map[string]*schema.Resource{"computed": computed})
+	diff, err = testResourceGroupDiff(t, computed, "foo", state, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && diff.Attributes["resource_group_id"] != nil {
+		t.Fatalf("expected no change of resource_group_id, got %#v", diff.Attributes["resource_group_id"])
+	}
+}
+
+func TestAddDefaultResourceGroup_customizeDiffChaining(t *testing.T) {
+	var called bool
+	var resourceGroupID string
+	computed := testResourceGroupResource(true, func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		called = true
+		resourceGroupID = diff.Get("resource_group_id").(string)
+		return nil
+	})
+	addDefaultResourceGroup(map[string]*schema.Resource{"computed": computed})
+
+	diff, err := testResourceGroupDiff(t, computed, "", nil, map[string]interface{}{"name": "foo"}, testClientSession{defaultResourceGroupID: "default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !called {
+		t.Fatal("CustomizeDiff of the resource was not called")
+	}
+	if resourceGroupID != "default" {
+		t.Fatalf("expected the CustomizeDiff of the resource to see the default group, got %q", resourceGroupID)
+	}
+	if attr := diff.Attributes["resource_group_id"]; attr == nil || attr.New != "default" {
+		t.Fatalf("expected resource_group_id to be planned as default, got %#v", attr)
+	}
+
+	// the configured group and the plan without default are left to the resource
+	called = false
+	diff, err = testResourceGroupDiff(t, computed, "", nil, map[string]interface{}{"name": "foo", "resource_group_id": "configured"}, testClientSession{defaultResourceGroupID: "default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !called || diff.Attributes["resource_group_id"].New != "configured" {
+		t.Fatalf("expected resource_group_id to be planned as configured, got %#v", diff.Attributes["resource_group_id"])
+	}
+	called = false
+	diff, err = testResourceGroupDiff(t, computed, "", nil, map[string]interface{}{"name": "foo"}, testClientSession{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !called || !diff.Attributes["resource_group_id"].NewComputed {
+		t.Fatalf("expected resource_group_id to be computed, got %#v", diff.Attributes["resource_group_id"])
+	}
+
+	// errors of the resource are returned
+	failing := testResourceGroupResource(true, func(context.Context, *schema.ResourceDiff, interface{}) error {
+		return errors.New("customize diff failed")
+	})
+	addDefaultResourceGroup(map[string]*schema.Resource{"failing": failing})
+	if _, err = testResourceGroupDiff(t, failing, "", nil, map[string]interface{}{"name": "foo"}, testClientSession{defaultResourceGroupID: "default"}); err == nil || err.Error() != "customize diff failed" {
+		t.Fatalf("expected the error of the resource, got %v", err)
+	}
+}
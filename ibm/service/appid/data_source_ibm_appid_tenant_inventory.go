@@ -0,0 +1,287 @@
+package appid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultThemeTabTitle = "Login"             // AppID default
+	defaultThemeFootnote = "Powered by App ID" // AppID default
+)
+
+func DataSourceIBMAppIDTenantInventory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the features that are configured in an App ID tenant and the IDs to import them.",
+		ReadContext: dataSourceIBMAppIDTenantInventoryRead,
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The AppID instance GUID",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"idp_cloud_directory_active": {
+				Description: "`true` if Cloud Directory IDP configuration is active",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"idp_facebook_active": {
+				Description: "`true` if Facebook IDP configuration is active",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"idp_google_active": {
+				Description: "`true` if Google IDP configuration is active",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"idp_custom_active": {
+				Description: "`true` if custom IDP configuration is active",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"idp_saml_active": {
+				Description: "`true` if SAML IDP configuration is active",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"mfa_active": {
+				Description: "`true` if MFA is active",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"redirect_urls": {
+				Description: "The redirect URLs of the tenant",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"theme_color_customized": {
+				Description: "`true` if the login widget header color differs from the App ID default",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"theme_text_customized": {
+				Description: "`true` if the login widget texts differ from the App ID defaults",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"applications": {
+				Description: "The applications of the tenant",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The application name",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"client_id": {
+							Description: "The application clientID",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"import_id": {
+							Description: "The ID to import the application with",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"roles": {
+				Description: "The roles of the tenant",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The role name",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"role_id": {
+							Description: "The role ID",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"import_id": {
+							Description: "The ID to import the role with",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"import_ids": {
+				Description: "The import ID of each configured tenant level resource, keyed by resource type",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceIBMAppIDTenantInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	appIDClient, err := meta.(conns.ClientSession).AppIDAPI()
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tenantID := d.Get("tenant_id").(string)
+	importIDs := map[string]interface{}{
+		"ibm_appid_token_config": tenantID,
+	}
+
+	cd, resp, err := appIDClient.GetCloudDirectoryIDPWithContext(ctx, &appid.GetCloudDirectoryIDPOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error loading AppID Cloud Directory IDP: %s\n%s", err, resp)
+	}
+	setAppIDInventoryActive(d, importIDs, "idp_cloud_directory_active", "ibm_appid_idp_cloud_directory", tenantID, cd.IsActive)
+
+	fb, resp, err := appIDClient.GetFacebookIDPWithContext(ctx, &appid.GetFacebookIDPOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error loading AppID Facebook IDP: %s\n%s", err, resp)
+	}
+	setAppIDInventoryActive(d, importIDs, "idp_facebook_active", "ibm_appid_idp_facebook", tenantID, fb.IsActive)
+
+	gg, resp, err := appIDClient.GetGoogleIDPWithContext(ctx, &appid.GetGoogleIDPOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error loading AppID Google IDP: %s\n%s", err, resp)
+	}
+	setAppIDInventoryActive(d, importIDs, "idp_google_active", "ibm_appid_idp_google", tenantID, gg.IsActive)
+
+	custom, resp, err := appIDClient.GetCustomIDPWithContext(ctx, &appid.GetCustomIDPOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error loading AppID custom IDP: %s\n%s", err, resp)
+	}
+	setAppIDInventoryActive(d, importIDs, "idp_custom_active", "ibm_appid_idp_custom", tenantID, custom.IsActive)
+
+	saml, resp, err := appIDClient.GetSAMLIDPWithContext(ctx, &appid.GetSAMLIDPOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error loading AppID SAML IDP: %s\n%s", err, resp)
+	}
+	setAppIDInventoryActive(d, importIDs, "idp_saml_active", "ibm_appid_idp_saml", tenantID, saml.IsActive)
+
+	mfa, resp, err := appIDClient.GetMFAConfigWithContext(ctx, &appid.GetMFAConfigOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error getting AppID MFA configuration: %s\n%s", err, resp)
+	}
+	setAppIDInventoryActive(d, importIDs, "mfa_active", "ibm_appid_mfa", tenantID, mfa.IsActive)
+
+	urls, resp, err := appIDClient.GetRedirectUrisWithContext(ctx, &appid.GetRedirectUrisOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error getting AppID redirect URLs: %s\n%s", err, resp)
+	}
+	d.Set("redirect_urls", urls.RedirectUris)
+	if len(urls.RedirectUris) > 0 {
+		importIDs["ibm_appid_redirect_urls"] = tenantID
+	}
+
+	colors, resp, err := appIDClient.GetThemeColorWithContext(ctx, &appid.GetThemeColorOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error getting AppID theme colors: %s\n%s", err, resp)
+	}
+	colorCustomized := colors.HeaderColor != nil && *colors.HeaderColor != defaultHeaderColor
+	d.Set("theme_color_customized", colorCustomized)
+	if colorCustomized {
+		importIDs["ibm_appid_theme_color"] = tenantID
+	}
+
+	text, resp, err := appIDClient.GetThemeTextWithContext(ctx, &appid.GetThemeTextOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error getting AppID theme text: %s\n%s", err, resp)
+	}
+	textCustomized := (text.TabTitle != nil && *text.TabTitle != defaultThemeTabTitle) || (text.Footnote != nil && *text.Footnote != defaultThemeFootnote)
+	d.Set("theme_text_customized", textCustomized)
+	if textCustomized {
+		importIDs["ibm_appid_theme_text"] = tenantID
+	}
+
+	apps, resp, err := appIDClient.ListApplicationsWithContext(ctx, &appid.ListApplicationsOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error listing AppID applications: %s\n%s", err, resp)
+	}
+	applications := make([]interface{}, 0, len(apps.Applications))
+	for _, app := range apps.Applications {
+		if app.ClientID == nil {
+			continue
+		}
+		application := map[string]interface{}{
+			"client_id": *app.ClientID,
+			"import_id": fmt.Sprintf("%s/%s", tenantID, *app.ClientID),
+		}
+		if app.Name != nil {
+			application["name"] = *app.Name
+		}
+		applications = append(applications, application)
+	}
+	if err := d.Set("applications", applications); err != nil {
+		return diag.Errorf("Error setting AppID applications: %s", err)
+	}
+
+	roleList, resp, err := appIDClient.ListRolesWithContext(ctx, &appid.ListRolesOptions{
+		TenantID: &tenantID,
+	})
+	if err != nil {
+		return diag.Errorf("Error listing AppID roles: %s\n%s", err, resp)
+	}
+	roles := make([]interface{}, 0, len(roleList.Roles))
+	for _, r := range roleList.Roles {
+		if r.ID == nil {
+			continue
+		}
+		role := map[string]interface{}{
+			"role_id":   *r.ID,
+			"import_id": fmt.Sprintf("%s/%s", tenantID, *r.ID),
+		}
+		if r.Name != nil {
+			role["name"] = *r.Name
+		}
+		roles = append(roles, role)
+	}
+	if err := d.Set("roles", roles); err != nil {
+		return diag.Errorf("Error setting AppID roles: %s", err)
+	}
+
+	d.Set("import_ids", importIDs)
+	d.SetId(tenantID)
+
+	return nil
+}
+
+func setAppIDInventoryActive(d *schema.ResourceData, importIDs map[string]interface{}, key, resourceType, tenantID string, isActive *bool) {
+	active := isActive != nil && *isActive
+	d.Set(key, active)
+	if active {
+		importIDs[resourceType] = tenantID
+	}
+}
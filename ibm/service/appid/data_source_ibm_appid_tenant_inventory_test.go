@@ -0,0 +1,44 @@
+package appid_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAppIDTenantInventoryDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: setupAppIDTenantInventoryDataSourceConfig(acc.AppIDTenantID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_appid_tenant_inventory.inventory", "tenant_id", acc.AppIDTenantID),
+					resource.TestCheckResourceAttr("data.ibm_appid_tenant_inventory.inventory", "theme_text_customized", "true"),
+					resource.TestCheckResourceAttr("data.ibm_appid_tenant_inventory.inventory", "import_ids.ibm_appid_theme_text", acc.AppIDTenantID),
+					resource.TestCheckResourceAttr("data.ibm_appid_tenant_inventory.inventory", "import_ids.ibm_appid_token_config", acc.AppIDTenantID),
+				),
+			},
+		},
+	})
+}
+
+func setupAppIDTenantInventoryDataSourceConfig(tenantID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_appid_theme_text" "text" {
+			tenant_id = "%s"
+			tab_title = "inventory title"
+			footnote = "inventory footnote"
+		}
+		data "ibm_appid_tenant_inventory" "inventory" {
+			tenant_id = ibm_appid_theme_text.text.tenant_id
+			depends_on = [
+				ibm_appid_theme_text.text
+			]
+		}
+	`, tenantID)
+}
@@ -46,9 +46,9 @@ func DataSourceIBMAppIDTokenConfig() *schema.Resource {
 				Computed: true,
 			},
 			"access_token_claim": {
-				Type:        schema.TypeSet,
+				Type:        schema.TypeList,
 				Computed:    true,
-				Description: "A set of objects that are created when claims that are related to access tokens are mapped",
+				Description: "The ordered list of mappings of claims into access tokens",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source": {
@@ -70,9 +70,9 @@ func DataSourceIBMAppIDTokenConfig() *schema.Resource {
 				},
 			},
 			"id_token_claim": {
-				Type:        schema.TypeSet,
+				Type:        schema.TypeList,
 				Computed:    true,
-				Description: "A set of objects that are created when claims that are related to identity tokens are mapped",
+				Description: "The ordered list of mappings of claims into identity tokens",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source": {
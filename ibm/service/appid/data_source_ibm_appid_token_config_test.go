@@ -31,13 +31,13 @@ func TestAccIBMAppIDTokenConfigDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "refresh_token_expires_in", "7200"),
 					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.#", "2"),
 					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "id_token_claim.#", "0"),
-					// the order here is deterministic: https://github.com/hashicorp/terraform-plugin-sdk/blob/main/helper/schema/set.go#L268
-					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.0.destination_claim", "employeeId"),
-					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.0.source", "appid_custom"),
-					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.0.source_claim", "employeeId"),
-					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.1.destination_claim", "groupIds"),
-					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.1.source", "roles"),
-					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.1.source_claim", ""),
+					// the mappings keep the order of the configuration
+					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.0.destination_claim", "groupIds"),
+					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.0.source", "roles"),
+					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.0.source_claim", ""),
+					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.1.destination_claim", "employeeId"),
+					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.1.source", "appid_custom"),
+					resource.TestCheckResourceAttr("data.ibm_appid_token_config.test_config", "access_token_claim.1.source_claim", "employeeId"),
 				),
 			},
 		},
@@ -18,6 +18,9 @@ func ResourceIBMAppIDThemeText() *schema.Resource {
 		ReadContext:   resourceIBMAppIDThemeTextRead,
 		UpdateContext: resourceIBMAppIDThemeTextUpdate,
 		DeleteContext: resourceIBMAppIDThemeTextDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
 				Type:        schema.TypeString,
@@ -114,8 +117,8 @@ func resourceIBMAppIDThemeTextDelete(ctx context.Context, d *schema.ResourceData
 
 	input := &appid.PostThemeTextOptions{
 		TenantID: &tenantID,
-		TabTitle: helpers.String("Login"),
-		Footnote: helpers.String("Powered by App ID"),
+		TabTitle: helpers.String(defaultThemeTabTitle),
+		Footnote: helpers.String(defaultThemeFootnote),
 	}
 
 	resp, err := appIDClient.PostThemeTextWithContext(ctx, input)
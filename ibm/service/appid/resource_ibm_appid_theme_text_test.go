@@ -26,6 +26,11 @@ func TestAccThemeText_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_appid_theme_text.text", "footnote", "resource test footnote"),
 				),
 			},
+			{
+				ResourceName:      "ibm_appid_theme_text.text",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
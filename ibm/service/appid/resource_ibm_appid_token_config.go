@@ -5,6 +5,7 @@ package appid
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -24,6 +25,7 @@ func ResourceIBMAppIDTokenConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceIBMAppIDTokenConfigValidateClaims,
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
 				Description: "The service `tenantId`",
@@ -60,56 +62,87 @@ func ResourceIBMAppIDTokenConfig() *schema.Resource {
 				Computed: true,
 			},
 			"access_token_claim": {
-				Description: "A set of objects that are created when claims that are related to access tokens are mapped",
-				Type:        schema.TypeSet,
+				Description: "The ordered list of mappings of claims into access tokens. When several mappings produce the same claim, the last one wins",
+				Type:        schema.TypeList,
 				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"source": {
-							Description:  "Defines the source of the claim. Options include: `saml`, `cloud_directory`, `facebook`, `google`, `appid_custom`, and `attributes`.",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"saml", "cloud_directory", "appid_custom", "facebook", "google", "ibmid", "attributes", "roles"}, false),
-						},
-						"source_claim": {
-							Description: "Defines the claim as provided by the source. It can refer to the identity provider's user information or the user's App ID custom attributes.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"destination_claim": {
-							Description: "Optional: Defines the custom attribute that can override the current claim in token.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-					},
-				},
+				Elem:        appIDTokenClaimResource(),
 			},
 			"id_token_claim": {
-				Description: "A set of objects that are created when claims that are related to identity tokens are mapped",
-				Type:        schema.TypeSet,
+				Description: "The ordered list of mappings of claims into identity tokens. When several mappings produce the same claim, the last one wins",
+				Type:        schema.TypeList,
 				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"source": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"saml", "cloud_directory", "appid_custom", "facebook", "google", "ibmid", "attributes", "roles"}, false),
-						},
-						"source_claim": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"destination_claim": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-					},
-				},
+				Elem:        appIDTokenClaimResource(),
 			},
 		},
 	}
 }
 
+// appIDReservedTokenClaims are set by App ID and cannot be overridden by a mapping
+var appIDReservedTokenClaims = []string{"iss", "aud", "sub", "iat", "exp", "amr", "tenant"}
+
+func appIDTokenClaimResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Description:  "Defines the source of the claim. Options include: `saml`, `cloud_directory`, `facebook`, `google`, `appid_custom`, `ibmid`, `roles` and `attributes`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"saml", "cloud_directory", "appid_custom", "facebook", "google", "ibmid", "attributes", "roles"}, false),
+			},
+			"source_claim": {
+				Description: "Defines the claim as provided by the source. It can refer to the identity provider's user information or the user's App ID custom attributes. Required for every source but `roles`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"destination_claim": {
+				Description: "Optional: Defines the custom attribute that can override the current claim in token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceIBMAppIDTokenConfigValidateClaims(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"access_token_claim", "id_token_claim"} {
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		for i, item := range diff.Get(key).([]interface{}) {
+			cMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			source := cMap["source"].(string)
+			sourceClaim := cMap["source_claim"].(string)
+			destinationClaim := cMap["destination_claim"].(string)
+
+			if source == "roles" && sourceClaim != "" {
+				return fmt.Errorf("%s.%d: source_claim cannot be set when source is `roles`, the roles of the user are mapped as a whole", key, i)
+			}
+
+			if source != "roles" && source != "" && sourceClaim == "" {
+				return fmt.Errorf("%s.%d: source_claim is required when source is `%s`", key, i, source)
+			}
+
+			claim := destinationClaim
+			if claim == "" {
+				claim = sourceClaim
+			}
+
+			for _, reserved := range appIDReservedTokenClaims {
+				if claim == reserved {
+					return fmt.Errorf("%s.%d: the `%s` claim is reserved and cannot be overridden", key, i, reserved)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceIBMAppIDTokenConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	appidClient, err := meta.(conns.ClientSession).AppIDAPI()
 
@@ -180,16 +213,13 @@ func resourceIBMAppIDTokenConfigRead(ctx context.Context, d *schema.ResourceData
 		d.Set("anonymous_token_expires_in", *tokenConfig.AnonymousAccess.ExpiresIn)
 	}
 
-	if tokenConfig.AccessTokenClaims != nil {
-		if err := d.Set("access_token_claim", flattenTokenClaims(tokenConfig.AccessTokenClaims)); err != nil {
-			return diag.FromErr(err)
-		}
+	// the mappings are always set, so that mappings removed outside of Terraform are detected
+	if err := d.Set("access_token_claim", flattenTokenClaims(tokenConfig.AccessTokenClaims)); err != nil {
+		return diag.FromErr(err)
 	}
 
-	if tokenConfig.IDTokenClaims != nil {
-		if err := d.Set("id_token_claim", flattenTokenClaims(tokenConfig.IDTokenClaims)); err != nil {
-			return diag.FromErr(err)
-		}
+	if err := d.Set("id_token_claim", flattenTokenClaims(tokenConfig.IDTokenClaims)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Set("tenant_id", tenantID)
@@ -211,12 +241,12 @@ func expandTokenClaims(l []interface{}) []appid.TokenClaimMapping {
 			Source: helpers.String(cMap["source"].(string)),
 		}
 
-		// source_claim and destination_claim are optional
-		if sClaim, ok := cMap["source_claim"]; ok {
+		// source_claim and destination_claim are optional, empty values are not sent
+		if sClaim, ok := cMap["source_claim"]; ok && sClaim.(string) != "" {
 			claim.SourceClaim = helpers.String(sClaim.(string))
 		}
 
-		if dClaim, ok := cMap["destination_claim"]; ok {
+		if dClaim, ok := cMap["destination_claim"]; ok && dClaim.(string) != "" {
 			claim.DestinationClaim = helpers.String(dClaim.(string))
 		}
 
@@ -271,11 +301,11 @@ func expandTokenConfig(d *schema.ResourceData) *appid.PutTokensConfigOptions {
 	}
 
 	if accessClaims, ok := d.GetOk("access_token_claim"); ok {
-		config.AccessTokenClaims = expandTokenClaims(accessClaims.(*schema.Set).List())
+		config.AccessTokenClaims = expandTokenClaims(accessClaims.([]interface{}))
 	}
 
 	if idClaims, ok := d.GetOk("id_token_claim"); ok {
-		config.IDTokenClaims = expandTokenClaims(idClaims.(*schema.Set).List())
+		config.IDTokenClaims = expandTokenClaims(idClaims.([]interface{}))
 	}
 
 	return config
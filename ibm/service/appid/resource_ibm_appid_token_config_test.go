@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appid_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMAppIDTokenConfig_claims(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAppIDTokenConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: setupAppIDTokenConfigClaims(acc.AppIDTenantID, "roles", "groupIds", "appid_custom"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_appid_token_config.tc", "access_token_claim.#", "2"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.tc", "access_token_claim.0.source", "roles"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.tc", "access_token_claim.1.source", "appid_custom"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.tc", "id_token_claim.#", "1"),
+				),
+			},
+			{
+				// reordering the mappings is an update
+				Config: setupAppIDTokenConfigClaims(acc.AppIDTenantID, "appid_custom", "employeeId", "roles"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_appid_token_config.tc", "access_token_claim.#", "2"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.tc", "access_token_claim.0.source", "appid_custom"),
+					resource.TestCheckResourceAttr("ibm_appid_token_config.tc", "access_token_claim.1.source", "roles"),
+				),
+			},
+			{
+				ResourceName:      "ibm_appid_token_config.tc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMAppIDTokenConfig_invalidClaims(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      setupAppIDTokenConfigInvalidClaim(acc.AppIDTenantID, "saml", "", "employeeId"),
+				ExpectError: regexp.MustCompile("source_claim is required"),
+			},
+			{
+				Config:      setupAppIDTokenConfigInvalidClaim(acc.AppIDTenantID, "roles", "groups", "groupIds"),
+				ExpectError: regexp.MustCompile("source_claim cannot be set"),
+			},
+			{
+				Config:      setupAppIDTokenConfigInvalidClaim(acc.AppIDTenantID, "appid_custom", "employeeId", "sub"),
+				ExpectError: regexp.MustCompile("reserved"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAppIDTokenConfigDestroy(s *terraform.State) error {
+	appIDClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).AppIDAPI()
+
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_appid_token_config" {
+			continue
+		}
+
+		tenantID := rs.Primary.ID
+
+		cfg, _, err := appIDClient.GetTokensConfig(&appid.GetTokensConfigOptions{
+			TenantID: &tenantID,
+		})
+
+		// the defaults have no claim mappings
+		if err != nil || len(cfg.AccessTokenClaims) != 0 || len(cfg.IDTokenClaims) != 0 {
+			return fmt.Errorf("[ERROR] Error checking if AppID token configuration (%s) has been destroyed", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func setupAppIDTokenConfigClaims(tenantID string, firstSource string, firstClaim string, secondSource string) string {
+	claim := func(source string, claim string) string {
+		if source == "roles" {
+			return fmt.Sprintf(`
+			access_token_claim {
+				source = "roles"
+				destination_claim = "%s"
+			}`, claim)
+		}
+		return fmt.Sprintf(`
+			access_token_claim {
+				source = "%s"
+				source_claim = "%s"
+				destination_claim = "%s"
+			}`, source, claim, claim)
+	}
+	secondClaim := "employeeId"
+	if secondSource == "roles" {
+		secondClaim = "groupIds"
+	}
+
+	return fmt.Sprintf(`
+		resource "ibm_appid_token_config" "tc" {
+			tenant_id = "%s"
+			%s
+			%s
+
+			id_token_claim {
+				source = "saml"
+				source_claim = "attributes.uid"
+				destination_claim = "uid"
+			}
+		}
+	`, tenantID, claim(firstSource, firstClaim), claim(secondSource, secondClaim))
+}
+
+func setupAppIDTokenConfigInvalidClaim(tenantID string, source string, sourceClaim string, destinationClaim string) string {
+	return fmt.Sprintf(`
+		resource "ibm_appid_token_config" "tc" {
+			tenant_id = "%s"
+
+			access_token_claim {
+				source = "%s"
+				source_claim = "%s"
+				destination_claim = "%s"
+			}
+		}
+	`, tenantID, source, sourceClaim, destinationClaim)
+}
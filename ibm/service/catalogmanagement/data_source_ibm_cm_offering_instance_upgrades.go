@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+)
+
+func DataSourceIBMCmOfferingInstanceUpgrades() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCmOfferingInstanceUpgradesRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID for the offering instance",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version the instance is currently installed from.",
+			},
+			"upgrades": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions the instance can be moved to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version_locator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A dotted value of `catalogID`.`versionID`.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version number of this version.",
+						},
+						"flavor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The programmatic name of the flavor of this version.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current state of this version.",
+						},
+						"package_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of package.",
+						},
+						"sha": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The SHA value of this version.",
+						},
+						"can_update": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the instance can be upgraded to this version.",
+						},
+						"messages": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The failed pre-upgrade checks, keyed by check, when can_update is false.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCmOfferingInstanceUpgradesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getOfferingInstanceOptions := &catalogmanagementv1.GetOfferingInstanceOptions{}
+
+	getOfferingInstanceOptions.SetInstanceIdentifier(d.Get("instance_identifier").(string))
+
+	offeringInstance, response, err := catalogManagementClient.GetOfferingInstanceWithContext(context, getOfferingInstanceOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetOfferingInstanceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	updates, err := getOfferingInstanceUpdates(context, catalogManagementClient, rsConClient.Config.IAMRefreshToken, offeringInstance)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*offeringInstance.ID)
+
+	if err = d.Set("version", offeringInstance.Version); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting version: %s", err))
+	}
+
+	upgrades := make([]map[string]interface{}, 0, len(updates))
+	for _, update := range updates {
+		upgrades = append(upgrades, dataSourceIBMCmOfferingInstanceUpgradesVersionUpdateDescriptorToMap(update))
+	}
+	if err = d.Set("upgrades", upgrades); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting upgrades: %s", err))
+	}
+
+	return nil
+}
+
+// getOfferingInstanceUpdates lists the versions the offering instance can be
+// moved to, checked against the cluster it is installed in.
+func getOfferingInstanceUpdates(context context.Context, catalogManagementClient *catalogmanagementv1.CatalogManagementV1, refreshToken string, offeringInstance *catalogmanagementv1.OfferingInstance) ([]catalogmanagementv1.VersionUpdateDescriptor, error) {
+	getOfferingUpdatesOptions := catalogManagementClient.NewGetOfferingUpdatesOptions(*offeringInstance.CatalogID, *offeringInstance.OfferingID, *offeringInstance.KindFormat, refreshToken)
+	getOfferingUpdatesOptions.Target = offeringInstance.KindTarget
+	getOfferingUpdatesOptions.Version = offeringInstance.Version
+	getOfferingUpdatesOptions.ClusterID = offeringInstance.ClusterID
+	getOfferingUpdatesOptions.Region = offeringInstance.ClusterRegion
+	getOfferingUpdatesOptions.ResourceGroupID = offeringInstance.ResourceGroupID
+	getOfferingUpdatesOptions.Sha = offeringInstance.Sha
+	getOfferingUpdatesOptions.Channel = offeringInstance.Channel
+	getOfferingUpdatesOptions.AllNamespaces = offeringInstance.ClusterAllNamespaces
+	if len(offeringInstance.ClusterNamespaces) > 0 {
+		getOfferingUpdatesOptions.SetNamespaces(offeringInstance.ClusterNamespaces)
+	}
+
+	updates, response, err := catalogManagementClient.GetOfferingUpdatesWithContext(context, getOfferingUpdatesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetOfferingUpdatesWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("[ERROR] Error getting upgrades of offering instance %s: %s", *offeringInstance.ID, err)
+	}
+	return updates, nil
+}
+
+func dataSourceIBMCmOfferingInstanceUpgradesVersionUpdateDescriptorToMap(update catalogmanagementv1.VersionUpdateDescriptor) map[string]interface{} {
+	upgrade := map[string]interface{}{}
+	if update.VersionLocator != nil {
+		upgrade["version_locator"] = *update.VersionLocator
+	}
+	if update.Version != nil {
+		upgrade["version"] = *update.Version
+	}
+	if update.Flavor != nil && update.Flavor.Name != nil {
+		upgrade["flavor"] = *update.Flavor.Name
+	}
+	if update.State != nil && update.State.Current != nil {
+		upgrade["state"] = *update.State.Current
+	}
+	if update.PackageVersion != nil {
+		upgrade["package_version"] = *update.PackageVersion
+	}
+	if update.Sha != nil {
+		upgrade["sha"] = *update.Sha
+	}
+	if update.CanUpdate != nil {
+		upgrade["can_update"] = *update.CanUpdate
+	}
+	if update.Messages != nil {
+		upgrade["messages"] = update.Messages
+	}
+	return upgrade
+}
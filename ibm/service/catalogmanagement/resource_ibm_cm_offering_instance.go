@@ -4,9 +4,11 @@
 package catalogmanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -33,6 +35,8 @@ func ResourceIBMCmOfferingInstance() *schema.Resource {
 		Exists:   resourceIBMCmOfferingInstanceExists,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMCmOfferingInstanceValidateUpgrade,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(4 * time.Minute),
 			Update: schema.DefaultTimeout(4 * time.Minute),
@@ -121,7 +125,13 @@ func ResourceIBMCmOfferingInstance() *schema.Resource {
 				Optional:         true,
 				DiffSuppressFunc: flex.ApplyOnce,
 				Default:          true,
-				Description:      "Whether to wait until the offering instance successfully provisions, or to return when accepted",
+				Description:      "Whether to wait until the offering instance successfully provisions or upgrades, or to return when accepted",
+			},
+			"validate_upgrade": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to fail the plan when the new version is not an upgrade that passes the pre-upgrade checks against the cluster",
 			},
 		},
 	}
@@ -192,7 +202,7 @@ func resourceIBMCmOfferingInstanceCreate(d *schema.ResourceData, meta interface{
 	d.SetId(*offeringInstance.ID)
 
 	if d.Get("wait_until_successful").(bool) {
-		if _, err = waitUntilSuccess(d, meta); err != nil {
+		if _, err = waitUntilSuccess(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
 			log.Print(err)
 			return err
 		}
@@ -203,7 +213,7 @@ func resourceIBMCmOfferingInstanceCreate(d *schema.ResourceData, meta interface{
 	return resourceIBMCmOfferingInstanceRead(d, meta)
 }
 
-func waitUntilSuccess(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitUntilSuccess(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
 		return nil, err
@@ -225,7 +235,7 @@ func waitUntilSuccess(d *schema.ResourceData, meta interface{}) (interface{}, er
 		},
 		Delay:      waitUntilInterval * 2,
 		MinTimeout: waitUntilInterval,
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForState()
@@ -375,9 +385,70 @@ func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if d.HasChange("version") && d.Get("wait_until_successful").(bool) {
+		if _, err = waitUntilSuccess(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			log.Print(err)
+			return err
+		}
+	}
+
 	return resourceIBMCmOfferingInstanceRead(d, meta)
 }
 
+func resourceIBMCmOfferingInstanceValidateUpgrade(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("validate_upgrade").(bool) || !diff.HasChange("version") || !diff.NewValueKnown("version") {
+		return nil
+	}
+
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	getOfferingInstanceOptions := &catalogmanagementv1.GetOfferingInstanceOptions{}
+
+	getOfferingInstanceOptions.SetInstanceIdentifier(diff.Id())
+
+	offeringInstance, response, err := catalogManagementClient.GetOfferingInstanceWithContext(context, getOfferingInstanceOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetOfferingInstanceWithContext failed %s\n%s", err, response)
+		return err
+	}
+
+	updates, err := getOfferingInstanceUpdates(context, catalogManagementClient, rsConClient.Config.IAMRefreshToken, offeringInstance)
+	if err != nil {
+		return err
+	}
+
+	version := diff.Get("version").(string)
+	available := make([]string, 0, len(updates))
+	for _, update := range updates {
+		if update.Version == nil {
+			continue
+		}
+		if *update.Version != version {
+			if update.CanUpdate != nil && *update.CanUpdate {
+				available = append(available, *update.Version)
+			}
+			continue
+		}
+		if update.CanUpdate != nil && !*update.CanUpdate {
+			checks := make([]string, 0, len(update.Messages))
+			for check, message := range update.Messages {
+				checks = append(checks, fmt.Sprintf("%s: %s", check, message))
+			}
+			return fmt.Errorf("[ERROR] Offering instance %s cannot be upgraded to version %s: %s", diff.Id(), version, strings.Join(checks, "; "))
+		}
+		return nil
+	}
+
+	return fmt.Errorf("[ERROR] Version %s is not an upgrade of offering instance %s, available upgrades: [%s]", version, diff.Id(), strings.Join(available, ", "))
+}
+
 func resourceIBMCmOfferingInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
@@ -17,6 +17,9 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 )
 
+// tektonPipelinePublicWorker is the ID of the IBM Managed shared workers
+const tektonPipelinePublicWorker = "public"
+
 func ResourceIBMCdTektonPipeline() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMCdTektonPipelineCreate,
@@ -48,7 +51,6 @@ func ResourceIBMCdTektonPipeline() *schema.Resource {
 						"id": &schema.Schema{
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "ID of the worker.",
 						},
 					},
@@ -563,7 +565,8 @@ func resourceIBMCdTektonPipelineRead(context context.Context, d *schema.Resource
 	if err = d.Set("enable_partial_cloning", tektonPipeline.EnablePartialCloning); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting enable_partial_cloning: %s", err))
 	}
-	if tektonPipeline.Worker != nil {
+	_, workerConfigured := d.GetOk("worker")
+	if tektonPipeline.Worker != nil && (workerConfigured || (tektonPipeline.Worker.ID != nil && *tektonPipeline.Worker.ID != tektonPipelinePublicWorker)) {
 		workerMap, err := resourceIBMCdTektonPipelineWorkerIdentityToMap(tektonPipeline.Worker)
 		if err != nil {
 			return diag.FromErr(err)
@@ -674,9 +677,13 @@ func resourceIBMCdTektonPipelineUpdate(context context.Context, d *schema.Resour
 		hasChange = true
 	}
 	if d.HasChange("worker") {
-		worker, err := resourceIBMCdTektonPipelineMapToWorkerIdentity(d.Get("worker.0").(map[string]interface{}))
-		if err != nil {
-			return diag.FromErr(err)
+		// Removing the worker pins the pipeline back to the IBM Managed shared workers
+		worker := &cdtektonpipelinev2.WorkerIdentity{ID: core.StringPtr(tektonPipelinePublicWorker)}
+		if _, ok := d.GetOk("worker"); ok {
+			worker, err = resourceIBMCdTektonPipelineMapToWorkerIdentity(d.Get("worker.0").(map[string]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
 		}
 		patchVals.Worker = worker
 		hasChange = true
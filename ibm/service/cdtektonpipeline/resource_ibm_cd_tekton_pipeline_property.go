@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -24,6 +25,11 @@ func ResourceIBMCdTektonPipelineProperty() *schema.Resource {
 		UpdateContext: resourceIBMCdTektonPipelinePropertyUpdate,
 		DeleteContext: resourceIBMCdTektonPipelinePropertyDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCdTektonPipelineSecurePropertyCustomizeDiff(diff)
+			},
+		),
 
 		Schema: map[string]*schema.Schema{
 			"pipeline_id": &schema.Schema{
@@ -126,6 +132,20 @@ func ResourceIBMCdTektonPipelinePropertyValidator() *validate.ResourceValidator
 	return &resourceValidator
 }
 
+// resourceIBMCdTektonPipelineSecurePropertyCustomizeDiff validates the format of secure property values that are
+// secret references, either {vault::integration_name.secret_name} or the CRN of a Secrets Manager secret
+func resourceIBMCdTektonPipelineSecurePropertyCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Get("type").(string) != "secure" {
+		return nil
+	}
+	if value, ok := diff.GetOk("value"); ok {
+		if _, errs := validate.ValidateSecretReference(value, "value"); len(errs) > 0 {
+			return errs[0]
+		}
+	}
+	return nil
+}
+
 func resourceIBMCdTektonPipelinePropertyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cdTektonPipelineClient, err := meta.(conns.ClientSession).CdTektonPipelineV2()
 	if err != nil {
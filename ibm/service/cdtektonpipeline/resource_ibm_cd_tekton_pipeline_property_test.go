@@ -5,6 +5,7 @@ package cdtektonpipeline_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -88,6 +89,31 @@ func TestAccIBMCdTektonPipelinePropertyAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMCdTektonPipelinePropertySecretReference(t *testing.T) {
+	var conf cdtektonpipelinev2.Property
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCdTektonPipelinePropertyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIBMCdTektonPipelinePropertyConfigSecure(name, "{vault::sm-compliance-secrets}.api-key}"),
+				ExpectError: regexp.MustCompile("must be a secret reference"),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCdTektonPipelinePropertyConfigSecure(name, "{vault::sm-compliance-secrets.api-key}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCdTektonPipelinePropertyExists("ibm_cd_tekton_pipeline_property.cd_tekton_pipeline_property", conf),
+					resource.TestCheckResourceAttr("ibm_cd_tekton_pipeline_property.cd_tekton_pipeline_property", "type", "secure"),
+					resource.TestCheckResourceAttr("ibm_cd_tekton_pipeline_property.cd_tekton_pipeline_property", "value", "{vault::sm-compliance-secrets.api-key}"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMCdTektonPipelinePropertyConfigBasic(pipelineID string, name string, typeVar string) string {
 	rgName := acc.CdResourceGroupName
 	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
@@ -229,3 +255,38 @@ func testAccCheckIBMCdTektonPipelinePropertyDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIBMCdTektonPipelinePropertyConfigSecure(name string, value string) string {
+	rgName := acc.CdResourceGroupName
+	tcName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+		resource "ibm_cd_toolchain" "cd_toolchain" {
+			name = "%s"
+			resource_group_id = data.ibm_resource_group.resource_group.id
+		}
+		resource "ibm_cd_toolchain_tool_pipeline" "ibm_cd_toolchain_tool_pipeline" {
+			toolchain_id = ibm_cd_toolchain.cd_toolchain.id
+			parameters {
+				name = "pipeline-name"
+			}
+		}
+		resource "ibm_cd_tekton_pipeline" "cd_tekton_pipeline" {
+			pipeline_id = ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline.tool_id
+			depends_on = [
+				ibm_cd_toolchain_tool_pipeline.ibm_cd_toolchain_tool_pipeline
+			]
+		}
+		resource "ibm_cd_tekton_pipeline_property" "cd_tekton_pipeline_property" {
+			pipeline_id = ibm_cd_tekton_pipeline.cd_tekton_pipeline.pipeline_id
+			name = "%s"
+			type = "secure"
+			value = "%s"
+			depends_on = [
+				ibm_cd_tekton_pipeline.cd_tekton_pipeline
+			]
+		}
+	`, rgName, tcName, name, value)
+}
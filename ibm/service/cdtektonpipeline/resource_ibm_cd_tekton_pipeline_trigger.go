@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -25,6 +26,15 @@ func ResourceIBMCdTektonPipelineTrigger() *schema.Resource {
 		UpdateContext: resourceIBMCdTektonPipelineTriggerUpdate,
 		DeleteContext: resourceIBMCdTektonPipelineTriggerDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				// A trigger can be pinned to another worker in place, but not unpinned
+				if o, n := diff.GetChange("worker"); len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+					return diff.ForceNew("worker")
+				}
+				return nil
+			},
+		),
 
 		Schema: map[string]*schema.Schema{
 			"pipeline_id": &schema.Schema{
@@ -68,7 +78,6 @@ func ResourceIBMCdTektonPipelineTrigger() *schema.Resource {
 						"id": &schema.Schema{
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
 							Description: "ID of the worker.",
 						},
 					},
@@ -530,7 +539,7 @@ func resourceIBMCdTektonPipelineTriggerUpdate(context context.Context, d *schema
 		patchVals.Tags = tags
 		hasChange = true
 	}
-	if d.HasChange("worker") {
+	if _, ok := d.GetOk("worker"); ok && d.HasChange("worker") {
 		worker, err := resourceIBMCdTektonPipelineTriggerMapToWorkerIdentity(d.Get("worker.0").(map[string]interface{}))
 		if err != nil {
 			return diag.FromErr(err)
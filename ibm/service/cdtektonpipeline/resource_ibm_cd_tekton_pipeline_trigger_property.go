@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -24,6 +25,11 @@ func ResourceIBMCdTektonPipelineTriggerProperty() *schema.Resource {
 		UpdateContext: resourceIBMCdTektonPipelineTriggerPropertyUpdate,
 		DeleteContext: resourceIBMCdTektonPipelineTriggerPropertyDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCdTektonPipelineSecurePropertyCustomizeDiff(diff)
+			},
+		),
 
 		Schema: map[string]*schema.Schema{
 			"pipeline_id": &schema.Schema{
@@ -65,6 +65,7 @@ func ResourceIBMCdToolchainToolArtifactory() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: flex.SuppressHashedRawSecret,
+							ValidateFunc:     validate.ValidateSecretReference,
 							Sensitive:        true,
 							Description:      "The Identity Token or API key for your Artifactory repository. You can use a toolchain secret reference for this parameter. For more information, see [Protecting your sensitive data in Continuous Delivery](https://cloud.ibm.com/docs/ContinuousDelivery?topic=ContinuousDelivery-cd_data_security#cd_secure_credentials).",
 						},
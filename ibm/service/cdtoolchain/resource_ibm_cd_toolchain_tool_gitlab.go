@@ -117,6 +117,7 @@ func ResourceIBMCdToolchainToolGitlab() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: flex.SuppressHashedRawSecret,
+							ValidateFunc:     validate.ValidateSecretReference,
 							Sensitive:        true,
 							Description:      "Personal Access Token. Required if ‘auth_type’ is set to ‘pat’, ignored otherwise.",
 						},
@@ -50,6 +50,7 @@ func ResourceIBMCdToolchainToolPagerduty() *schema.Resource {
 							Type:             schema.TypeString,
 							Required:         true,
 							DiffSuppressFunc: flex.SuppressHashedRawSecret,
+							ValidateFunc:     validate.ValidateSecretReference,
 							Sensitive:        true,
 							Description:      "The PagerDuty service integration key. You can find or create this key in the Integrations section of the PagerDuty service page.",
 						},
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"fmt"
 	"log"
 	"time"
 
@@ -24,6 +25,8 @@ const (
 	cisCertificateOrderStatus        = "status"
 	cisCertificateOrderDeleted       = "deleted"
 	cisCertificateOrderDeletePending = "deleting"
+	cisCertificateOrderWaitForActive = "wait_for_active"
+	cisCertificateOrderActive        = "active"
 )
 
 func ResourceIBMCISCertificateOrder() *schema.Resource {
@@ -34,6 +37,9 @@ func ResourceIBMCISCertificateOrder() *schema.Resource {
 		Delete:   ResourceIBMCISCertificateOrderDelete,
 		Exists:   ResourceIBMCISCertificateOrderExist,
 		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -72,6 +78,12 @@ func ResourceIBMCISCertificateOrder() *schema.Resource {
 				Description: "certificate status",
 				Computed:    true,
 			},
+			cisCertificateOrderWaitForActive: {
+				Type:        schema.TypeBool,
+				Description: "Wait for the ordered certificate to be validated, issued and deployed",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -124,6 +136,13 @@ func ResourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface
 	}
 
 	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
+
+	if d.Get(cisCertificateOrderWaitForActive).(bool) {
+		_, err = waitForCISCertificateOrderActive(d, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for certificate order (%s) to be active: %s", d.Id(), err)
+		}
+	}
 	return ResourceIBMCISCertificateOrderRead(d, meta)
 }
 
@@ -240,3 +259,38 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 
 	return stateConf.WaitForState()
 }
+
+func waitForCISCertificateOrderActive(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return nil, err
+	}
+	certificateID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+	opt := cisClient.NewGetCustomCertificateOptions(certificateID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"initializing", "pending_validation", "pending_issuance", "pending_deployment"},
+		Target:  []string{cisCertificateOrderActive},
+		Refresh: func() (interface{}, string, error) {
+			result, detail, err := cisClient.GetCustomCertificate(opt)
+			if err != nil {
+				log.Printf("Certificate read failed: %v", detail)
+				return nil, "", err
+			}
+			if result.Result == nil || result.Result.Status == nil {
+				return result, "", fmt.Errorf("[ERROR] Certificate %s has no status", certificateID)
+			}
+			return result, *result.Result.Status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		MinTimeout:   10 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
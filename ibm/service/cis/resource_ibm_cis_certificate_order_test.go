@@ -36,6 +36,26 @@ func TestAccIBMCisCertificateOrder_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisCertificateOrder_WaitForActive(t *testing.T) {
+	var certificate string
+	name := "ibm_cis_certificate_order.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckCis(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckCisCertificateOrderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisCertificateOrderConfigWaitForActive(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCisCertificateOrderExists(name, &certificate),
+					resource.TestCheckResourceAttr(name, "status", "active"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCisCertificateOrder_import(t *testing.T) {
 	name := "ibm_cis_certificate_order.test"
 
@@ -170,3 +190,14 @@ func testAccCheckCisCertificateOrderConfigBasic() string {
 	  }
 	`, acc.CisDomainStatic)
 }
+
+func testAccCheckCisCertificateOrderConfigWaitForActive() string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_certificate_order" "test" {
+		cis_id          = data.ibm_cis.cis.id
+		domain_id       = data.ibm_cis_domain.cis_domain.domain_id
+		hosts           = ["%[1]s"]
+		wait_for_active = true
+	  }
+	`, acc.CisDomainStatic)
+}
@@ -0,0 +1,481 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cisDNSRecordsRecord = "record"
+
+	// A TXT character string holds at most 255 characters, longer values are
+	// split into several quoted strings.
+	cisDNSRecordTXTChunkSize = 255
+	cisDNSRecordsPerPage     = 1000
+)
+
+// record types that are configured by name and content only
+var cisDNSRecordsTypes = []string{
+	cisDNSRecordTypeA,
+	cisDNSRecordTypeAAAA,
+	cisDNSRecordTypeCNAME,
+	cisDNSRecordTypeMX,
+	cisDNSRecordTypeNS,
+	cisDNSRecordTypePTR,
+	cisDNSRecordTypeSPF,
+	cisDNSRecordTypeTXT,
+}
+
+func ResourceIBMCISDnsRecords() *schema.Resource {
+	return &schema.Resource{
+		Create:   ResourceIBMCISDnsRecordsCreate,
+		Read:     ResourceIBMCISDnsRecordsRead,
+		Update:   ResourceIBMCISDnsRecordsUpdate,
+		Delete:   ResourceIBMCISDnsRecordsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS object id or CRN",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator("ibm_cis_dns_records",
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSRecordsRecord: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "DNS records of the domain managed by this resource",
+				Set:         resourceIBMCISDnsRecordsHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDNSRecordName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "DNS record name, @ for the domain itself",
+						},
+						cisDNSRecordType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues(cisDNSRecordsTypes),
+							Description:  "Record type",
+						},
+						cisDNSRecordContent: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "DNS record content, TXT values longer than 255 characters are split into ordered strings",
+						},
+						cisDNSRecordPriority: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Priority Value, only used by MX records",
+						},
+						cisDNSRecordProxied: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Boolean value true if proxied else false, only used by A, AAAA and CNAME records",
+						},
+						cisDNSRecordTTL: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "TTL value",
+						},
+						cisDNSRecordID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record ID",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISDnsRecordsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	ibmCISDNSRecordsValidator := validate.ResourceValidator{
+		ResourceName: "ibm_cis_dns_records",
+		Schema:       validateSchema}
+	return &ibmCISDNSRecordsValidator
+}
+
+func ResourceIBMCISDnsRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	crn := d.Get(cisID).(string)
+	zoneID, _, err := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	records := make([]map[string]interface{}, 0)
+	for _, r := range d.Get(cisDNSRecordsRecord).(*schema.Set).List() {
+		record := r.(map[string]interface{})
+		recordID, err := resourceIBMCISDnsRecordsCreateRecord(sess, record)
+		if err != nil {
+			// keep the records created so far in the state
+			if len(records) > 0 {
+				d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+				d.Set(cisDNSRecordsRecord, records)
+			}
+			return err
+		}
+		record[cisDNSRecordID] = recordID
+		records = append(records, record)
+	}
+
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	d.Set(cisDNSRecordsRecord, records)
+	return ResourceIBMCISDnsRecordsRead(d, meta)
+}
+
+func ResourceIBMCISDnsRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	existing, err := resourceIBMCISDnsRecordsList(sess)
+	if err != nil {
+		return err
+	}
+
+	records := make([]map[string]interface{}, 0)
+	managed := d.Get(cisDNSRecordsRecord).(*schema.Set).List()
+	if len(managed) == 0 {
+		// import, take over every record of a supported type
+		for _, result := range existing {
+			if resourceIBMCISDnsRecordsSupported(*result.Type) {
+				records = append(records, resourceIBMCISDnsRecordsToMap(result, nil))
+			}
+		}
+	}
+	for _, r := range managed {
+		record := r.(map[string]interface{})
+		result, ok := existing[record[cisDNSRecordID].(string)]
+		if !ok {
+			// deleted outside of terraform, it is created again on the next apply
+			continue
+		}
+		records = append(records, resourceIBMCISDnsRecordsToMap(result, record))
+	}
+
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisDNSRecordsRecord, records)
+	return nil
+}
+
+func ResourceIBMCISDnsRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	if d.HasChange(cisDNSRecordsRecord) {
+		o, n := d.GetChange(cisDNSRecordsRecord)
+		oldRecords, newRecords := o.(*schema.Set), n.(*schema.Set)
+
+		records := make([]map[string]interface{}, 0)
+		for _, r := range oldRecords.List() {
+			if newRecords.Contains(r) {
+				records = append(records, r.(map[string]interface{}))
+			}
+		}
+
+		// Changed records with the same name and type are updated in place,
+		// the remaining ones are deleted and created.
+		removed := map[string][]map[string]interface{}{}
+		for _, r := range oldRecords.Difference(newRecords).List() {
+			record := r.(map[string]interface{})
+			key := resourceIBMCISDnsRecordsKey(record)
+			removed[key] = append(removed[key], record)
+		}
+		for _, r := range newRecords.Difference(oldRecords).List() {
+			record := r.(map[string]interface{})
+			key := resourceIBMCISDnsRecordsKey(record)
+			if previous := removed[key]; len(previous) > 0 {
+				recordID := previous[0][cisDNSRecordID].(string)
+				removed[key] = previous[1:]
+				if err = resourceIBMCISDnsRecordsUpdateRecord(sess, recordID, record); err != nil {
+					return err
+				}
+				record[cisDNSRecordID] = recordID
+				records = append(records, record)
+				continue
+			}
+			recordID, err := resourceIBMCISDnsRecordsCreateRecord(sess, record)
+			if err != nil {
+				return err
+			}
+			record[cisDNSRecordID] = recordID
+			records = append(records, record)
+		}
+		for _, previous := range removed {
+			for _, record := range previous {
+				if err = resourceIBMCISDnsRecordsDeleteRecord(sess, record[cisDNSRecordID].(string)); err != nil {
+					return err
+				}
+			}
+		}
+		d.Set(cisDNSRecordsRecord, records)
+	}
+	return ResourceIBMCISDnsRecordsRead(d, meta)
+}
+
+func ResourceIBMCISDnsRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+
+	for _, r := range d.Get(cisDNSRecordsRecord).(*schema.Set).List() {
+		record := r.(map[string]interface{})
+		if err = resourceIBMCISDnsRecordsDeleteRecord(sess, record[cisDNSRecordID].(string)); err != nil {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMCISDnsRecordsList reads every record of the zone with as few requests as possible
+func resourceIBMCISDnsRecordsList(sess *dnsrecordsv1.DnsRecordsV1) (map[string]dnsrecordsv1.DnsrecordDetails, error) {
+	records := map[string]dnsrecordsv1.DnsrecordDetails{}
+	opt := sess.NewListAllDnsRecordsOptions()
+	opt.SetPerPage(cisDNSRecordsPerPage)
+	for page := int64(1); ; page++ {
+		opt.SetPage(page)
+		result, response, err := sess.ListAllDnsRecords(opt)
+		if err != nil {
+			log.Printf("Error listing dns records: %s", response)
+			return nil, err
+		}
+		for _, record := range result.Result {
+			records[*record.ID] = record
+		}
+		if result.ResultInfo == nil || result.ResultInfo.TotalCount == nil || int64(len(records)) >= *result.ResultInfo.TotalCount || len(result.Result) == 0 {
+			break
+		}
+	}
+	return records, nil
+}
+
+func resourceIBMCISDnsRecordsCreateRecord(sess *dnsrecordsv1.DnsRecordsV1, record map[string]interface{}) (string, error) {
+	recordType := record[cisDNSRecordType].(string)
+	opt := sess.NewCreateDnsRecordOptions()
+	opt.SetType(recordType)
+	opt.SetName(record[cisDNSRecordName].(string))
+	opt.SetContent(resourceIBMCISDnsRecordsContent(recordType, record[cisDNSRecordContent].(string)))
+	opt.SetTTL(int64(record[cisDNSRecordTTL].(int)))
+	if recordType == cisDNSRecordTypeMX {
+		opt.SetPriority(int64(record[cisDNSRecordPriority].(int)))
+	}
+
+	result, response, err := sess.CreateDnsRecord(opt)
+	if err != nil {
+		log.Printf("Error creating dns record: %s, error %s", response, err)
+		return "", fmt.Errorf("[ERROR] Error creating %s record %s: %s", recordType, record[cisDNSRecordName], err)
+	}
+	recordID := *result.Result.ID
+
+	// proxied can only be set once the record exists
+	if record[cisDNSRecordProxied].(bool) && resourceIBMCISDnsRecordsProxiable(recordType) {
+		if err = resourceIBMCISDnsRecordsUpdateRecord(sess, recordID, record); err != nil {
+			return recordID, err
+		}
+	}
+	return recordID, nil
+}
+
+func resourceIBMCISDnsRecordsUpdateRecord(sess *dnsrecordsv1.DnsRecordsV1, recordID string, record map[string]interface{}) error {
+	recordType := record[cisDNSRecordType].(string)
+	opt := sess.NewUpdateDnsRecordOptions(recordID)
+	opt.SetType(recordType)
+	opt.SetName(record[cisDNSRecordName].(string))
+	opt.SetContent(resourceIBMCISDnsRecordsContent(recordType, record[cisDNSRecordContent].(string)))
+	opt.SetTTL(int64(record[cisDNSRecordTTL].(int)))
+	if recordType == cisDNSRecordTypeMX {
+		opt.SetPriority(int64(record[cisDNSRecordPriority].(int)))
+	}
+	if resourceIBMCISDnsRecordsProxiable(recordType) {
+		opt.SetProxied(record[cisDNSRecordProxied].(bool))
+	}
+
+	_, response, err := sess.UpdateDnsRecord(opt)
+	if err != nil {
+		log.Printf("Error updating dns record: %s, error %s", response, err)
+		return fmt.Errorf("[ERROR] Error updating %s record %s: %s", recordType, record[cisDNSRecordName], err)
+	}
+	return nil
+}
+
+func resourceIBMCISDnsRecordsDeleteRecord(sess *dnsrecordsv1.DnsRecordsV1, recordID string) error {
+	if recordID == "" {
+		return nil
+	}
+	_, response, err := sess.DeleteDnsRecord(sess.NewDeleteDnsRecordOptions(recordID))
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("Error deleting dns record %s: %s", recordID, response)
+		return err
+	}
+	return nil
+}
+
+// resourceIBMCISDnsRecordsToMap flattens a record, keeping the name and content as
+// configured when they are equivalent to the values returned by the API
+func resourceIBMCISDnsRecordsToMap(result dnsrecordsv1.DnsrecordDetails, configured map[string]interface{}) map[string]interface{} {
+	record := map[string]interface{}{
+		cisDNSRecordID:       *result.ID,
+		cisDNSRecordName:     *result.Name,
+		cisDNSRecordType:     *result.Type,
+		cisDNSRecordProxied:  false,
+		cisDNSRecordTTL:      1,
+		cisDNSRecordPriority: 0,
+	}
+	if result.Content != nil {
+		record[cisDNSRecordContent] = resourceIBMCISDnsRecordsUnchunk(*result.Type, *result.Content)
+	}
+	if result.Proxied != nil {
+		record[cisDNSRecordProxied] = *result.Proxied
+	}
+	if result.TTL != nil {
+		record[cisDNSRecordTTL] = int(*result.TTL)
+	}
+	if result.Priority != nil && *result.Type == cisDNSRecordTypeMX {
+		record[cisDNSRecordPriority] = int(*result.Priority)
+	}
+	if configured != nil {
+		zoneName := ""
+		if result.ZoneName != nil {
+			zoneName = *result.ZoneName
+		}
+		if resourceIBMCISDnsRecordsFQDN(configured[cisDNSRecordName].(string), zoneName) == strings.ToLower(*result.Name) {
+			record[cisDNSRecordName] = configured[cisDNSRecordName]
+		}
+		content := configured[cisDNSRecordContent].(string)
+		if result.Content != nil && resourceIBMCISDnsRecordsContent(*result.Type, content) == *result.Content {
+			record[cisDNSRecordContent] = content
+		}
+	}
+	return record
+}
+
+// resourceIBMCISDnsRecordsContent splits TXT values longer than a character string into ordered quoted strings
+func resourceIBMCISDnsRecordsContent(recordType, content string) string {
+	if recordType != cisDNSRecordTypeTXT || len(content) <= cisDNSRecordTXTChunkSize || strings.HasPrefix(content, "\"") {
+		return content
+	}
+	chunks := make([]string, 0, len(content)/cisDNSRecordTXTChunkSize+1)
+	for len(content) > cisDNSRecordTXTChunkSize {
+		chunks = append(chunks, fmt.Sprintf("%q", content[:cisDNSRecordTXTChunkSize]))
+		content = content[cisDNSRecordTXTChunkSize:]
+	}
+	chunks = append(chunks, fmt.Sprintf("%q", content))
+	return strings.Join(chunks, " ")
+}
+
+// resourceIBMCISDnsRecordsUnchunk joins a TXT value that was split into quoted strings
+func resourceIBMCISDnsRecordsUnchunk(recordType, content string) string {
+	if recordType != cisDNSRecordTypeTXT || !strings.HasPrefix(content, "\"") || !strings.HasSuffix(content, "\"") {
+		return content
+	}
+	chunks := strings.Split(strings.TrimSuffix(strings.TrimPrefix(content, "\""), "\""), "\" \"")
+	return strings.Join(chunks, "")
+}
+
+func resourceIBMCISDnsRecordsFQDN(name, zoneName string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	zoneName = strings.ToLower(zoneName)
+	if name == "@" || name == "" {
+		return zoneName
+	}
+	if zoneName == "" || name == zoneName || strings.HasSuffix(name, "."+zoneName) {
+		return name
+	}
+	return name + "." + zoneName
+}
+
+func resourceIBMCISDnsRecordsSupported(recordType string) bool {
+	for _, t := range cisDNSRecordsTypes {
+		if t == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceIBMCISDnsRecordsProxiable(recordType string) bool {
+	return recordType == cisDNSRecordTypeA || recordType == cisDNSRecordTypeAAAA || recordType == cisDNSRecordTypeCNAME
+}
+
+// resourceIBMCISDnsRecordsKey pairs changed records that can be updated in place
+func resourceIBMCISDnsRecordsKey(record map[string]interface{}) string {
+	return fmt.Sprintf("%s|%s", strings.ToLower(record[cisDNSRecordName].(string)), record[cisDNSRecordType])
+}
+
+func resourceIBMCISDnsRecordsHash(v interface{}) int {
+	record := v.(map[string]interface{})
+	priority := 0
+	if record[cisDNSRecordType] == cisDNSRecordTypeMX {
+		priority, _ = record[cisDNSRecordPriority].(int)
+	}
+	proxied := false
+	if resourceIBMCISDnsRecordsProxiable(record[cisDNSRecordType].(string)) {
+		proxied, _ = record[cisDNSRecordProxied].(bool)
+	}
+	return conns.String(fmt.Sprintf("%s|%s|%v|%d|%t",
+		resourceIBMCISDnsRecordsKey(record), record[cisDNSRecordContent], record[cisDNSRecordTTL], priority, proxied))
+}
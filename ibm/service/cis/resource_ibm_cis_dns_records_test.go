@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSRecords_Basic(t *testing.T) {
+	resourceName := "ibm_cis_dns_records.records"
+	longTXT := strings.Repeat("v=tf-acctest-", 30)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckCis(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCisDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCisDNSRecordsConfig("192.168.0.10", false, longTXT),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":    "tf-acctest-bulk-txt",
+						"type":    "TXT",
+						"content": longTXT,
+					}),
+				),
+			},
+			{
+				// content and proxied change in place, the record set keeps its size
+				Config: testAccCheckIBMCisDNSRecordsConfig("192.168.0.11", true, longTXT),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":    "tf-acctest-bulk-a",
+						"content": "192.168.0.11",
+						"proxied": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCisDNSRecordsConfig(address string, proxied bool, txt string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_dns_records" "records" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+
+		record {
+			name    = "tf-acctest-bulk-a"
+			type    = "A"
+			content = "%[1]s"
+			proxied = %[2]t
+		}
+		record {
+			name    = "tf-acctest-bulk-cname"
+			type    = "CNAME"
+			content = "tf-acctest-bulk-a.%[4]s"
+		}
+		record {
+			name    = "tf-acctest-bulk-txt"
+			type    = "TXT"
+			content = "%[3]s"
+		}
+	}
+	`, address, proxied, txt, acc.CisDomainStatic)
+}
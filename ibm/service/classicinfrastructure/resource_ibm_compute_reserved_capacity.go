@@ -178,7 +178,7 @@ func resourceIBMComputeReservedCapacityCreate(context context.Context, d *schema
 	}
 
 	// wait for machine availability
-	reservedCapacity, err := findReservedCapacityByOrderID(name, d, meta)
+	reservedCapacity, err := findReservedCapacityByOrderID(context, name, d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"[Error] waiting for reserved capacity (%s) to become ready: %s", d.Id(), err))
@@ -188,7 +188,7 @@ func resourceIBMComputeReservedCapacityCreate(context context.Context, d *schema
 	d.SetId(fmt.Sprintf("%d", id))
 	return resourceIBMComputeReservedCapacityRead(context, d, meta)
 }
-func findReservedCapacityByOrderID(name string, r *schema.ResourceData, meta interface{}) (interface{}, error) {
+func findReservedCapacityByOrderID(context context.Context, name string, r *schema.ResourceData, meta interface{}) (interface{}, error) {
 
 	log.Printf("Waiting for reserved capacity  (%s) to have to be provisioned", name)
 
@@ -217,7 +217,7 @@ func findReservedCapacityByOrderID(name string, r *schema.ResourceData, meta int
 		MinTimeout: 1 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func resourceIBMComputeReservedCapacityRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
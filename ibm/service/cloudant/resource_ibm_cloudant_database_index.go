@@ -0,0 +1,314 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantDatabaseIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantDatabaseIndexCreate,
+		ReadContext:   resourceIBMCloudantDatabaseIndexRead,
+		DeleteContext: resourceIBMCloudantDatabaseIndexDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the database name.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Name of the index. If not specified, the server generates one.",
+			},
+			"ddoc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Name of the design document in which the index is created, without the _design/ prefix. If not specified, the server generates one.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "json",
+				ValidateFunc: validation.StringInSlice([]string{"json", "text"}, false),
+				Description:  "The type of the index, json or text.",
+			},
+			"partitioned": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether the index is partitioned. The default is the partitioning of the database.",
+			},
+			"fields": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The fields of the document to index.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The name of the field.",
+						},
+						"direction": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+							Description:  "The sort direction of the field in a json index, asc or desc. The default is asc.",
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{cloudantv1.IndexFieldTypeBooleanConst, cloudantv1.IndexFieldTypeNumberConst, cloudantv1.IndexFieldTypeStringConst}, false),
+							Description:  "The type of the field in a text index, boolean, number or string.",
+						},
+					},
+				},
+			},
+			"partial_filter_selector": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "JSON selector that limits the documents added to the index.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantDatabaseIndexCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	indexType := d.Get("type").(string)
+	indexDefinition := &cloudantv1.IndexDefinition{}
+	for _, f := range d.Get("fields").([]interface{}) {
+		field := f.(map[string]interface{})
+		name := field["name"].(string)
+		indexField := cloudantv1.IndexField{}
+		if indexType == "text" {
+			indexField.Name = flex.PtrToString(name)
+			if field["type"].(string) != "" {
+				indexField.Type = flex.PtrToString(field["type"].(string))
+			}
+		} else {
+			// Fields of json indexes use the sort syntax, {"<name>": "<direction>"}.
+			direction := "asc"
+			if field["direction"].(string) != "" {
+				direction = field["direction"].(string)
+			}
+			indexField.SetProperty(name, flex.PtrToString(direction))
+		}
+		indexDefinition.Fields = append(indexDefinition.Fields, indexField)
+	}
+	if selector, ok := d.GetOk("partial_filter_selector"); ok {
+		var partialFilterSelector map[string]interface{}
+		if err = json.Unmarshal([]byte(selector.(string)), &partialFilterSelector); err != nil {
+			return diag.FromErr(fmt.Errorf("Error parsing partial_filter_selector: %s", err))
+		}
+		indexDefinition.PartialFilterSelector = partialFilterSelector
+	}
+
+	dbName := d.Get("db").(string)
+	postIndexOptions := cloudantClient.NewPostIndexOptions(dbName, indexDefinition)
+	postIndexOptions.SetType(indexType)
+	if name, ok := d.GetOk("name"); ok {
+		postIndexOptions.SetName(name.(string))
+	}
+	if ddoc, ok := d.GetOk("ddoc"); ok {
+		postIndexOptions.SetDdoc(ddoc.(string))
+	}
+	if partitioned, ok := d.GetOkExists("partitioned"); ok {
+		postIndexOptions.SetPartitioned(partitioned.(bool))
+	}
+
+	indexResult, response, err := cloudantClient.PostIndexWithContext(context, postIndexOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PostIndexWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PostIndexWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", instanceCRN, dbName, strings.TrimPrefix(*indexResult.ID, "_design/"), *indexResult.Name))
+
+	return resourceIBMCloudantDatabaseIndexRead(context, d, meta)
+}
+
+func resourceIBMCloudantDatabaseIndexRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, name, err := cloudantDatabaseIndexIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getIndexesInformationOptions := cloudantClient.NewGetIndexesInformationOptions(dbName)
+
+	indexesInformation, response, err := cloudantClient.GetIndexesInformationWithContext(context, getIndexesInformationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetIndexesInformationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetIndexesInformationWithContext failed %s\n%s", err, response))
+	}
+
+	var index *cloudantv1.IndexInformation
+	for i := range indexesInformation.Indexes {
+		if *indexesInformation.Indexes[i].Ddoc == "_design/"+ddoc && *indexesInformation.Indexes[i].Name == name {
+			index = &indexesInformation.Indexes[i]
+		}
+	}
+	if index == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+
+	if err = d.Set("name", name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+
+	if err = d.Set("ddoc", ddoc); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ddoc: %s", err))
+	}
+
+	if err = d.Set("type", *index.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+
+	if index.Def != nil {
+		if err = d.Set("fields", flattenCloudantIndexFields(*index.Type, index.Def.Fields)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting fields: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantDatabaseIndexDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, name, err := cloudantDatabaseIndexIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteIndexOptions := cloudantClient.NewDeleteIndexOptions(dbName, ddoc, d.Get("type").(string), name)
+
+	_, response, err := cloudantClient.DeleteIndexWithContext(context, deleteIndexOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteIndexWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteIndexWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// cloudantDatabaseIndexIdParts splits an ID of the form <instance_crn>/<db>/<ddoc>/<name>.
+func cloudantDatabaseIndexIdParts(id string) (instanceCRN, dbName, ddoc, name string, err error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return
+	}
+	if len(parts) < 4 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of instance_crn/db/ddoc/name", id)
+		return
+	}
+	n := len(parts)
+	return strings.Join(parts[:n-3], "/"), parts[n-3], parts[n-2], parts[n-1], nil
+}
+
+func flattenCloudantIndexFields(indexType string, fields []cloudantv1.IndexField) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, field := range fields {
+		if field.Name != nil {
+			result = append(result, map[string]interface{}{
+				"name": *field.Name,
+				"type": core.StringNilMapper(field.Type),
+			})
+			continue
+		}
+		// Fields are returned as {"<name>": "<direction or type>"}.
+		properties := field.GetProperties()
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			value := core.StringNilMapper(properties[name])
+			if indexType == "text" {
+				result = append(result, map[string]interface{}{"name": name, "type": value})
+			} else {
+				result = append(result, map[string]interface{}{"name": name, "direction": value})
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cloudant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCloudantDatabaseIndexBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDatabaseIndexDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDatabaseIndexConfig(instanceName, db, "desc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_database_index.cloudant_index", "name", "by-created"),
+					resource.TestCheckResourceAttr("ibm_cloudant_database_index.cloudant_index", "ddoc", "tf-indexes"),
+					resource.TestCheckResourceAttr("ibm_cloudant_database_index.cloudant_index", "fields.0.name", "created"),
+					resource.TestCheckResourceAttr("ibm_cloudant_database_index.cloudant_index", "fields.0.direction", "desc"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_cloudant_database_index.cloudant_index",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partial_filter_selector", "partitioned"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantDatabaseIndexConfig(instanceName, db, direction string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_database_index" "cloudant_index" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = ibm_cloudant_database.cloudant_database.db
+			name = "by-created"
+			ddoc = "tf-indexes"
+			fields {
+				name = "created"
+				direction = "%s"
+			}
+			partial_filter_selector = jsonencode({ type = "order" })
+		}
+	`, instanceName, db, direction)
+}
+
+func testAccCheckIBMCloudantDatabaseIndexDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_database_index" {
+			continue
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			// The instance is deleted with the rest of the configuration.
+			continue
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getIndexesInformationOptions := cloudantClient.NewGetIndexesInformationOptions(rs.Primary.Attributes["db"])
+
+		indexesInformation, _, err := cloudantClient.GetIndexesInformation(getIndexesInformationOptions)
+		if err != nil {
+			continue
+		}
+		for _, index := range indexesInformation.Indexes {
+			if *index.Ddoc == "_design/"+rs.Primary.Attributes["ddoc"] && *index.Name == rs.Primary.Attributes["name"] {
+				return fmt.Errorf("cloudant_database_index still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
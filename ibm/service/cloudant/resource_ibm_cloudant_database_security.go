@@ -0,0 +1,283 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantDatabaseSecurity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantDatabaseSecurityCreate,
+		ReadContext:   resourceIBMCloudantDatabaseSecurityRead,
+		UpdateContext: resourceIBMCloudantDatabaseSecurityUpdate,
+		DeleteContext: resourceIBMCloudantDatabaseSecurityDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the database name.",
+			},
+			"admins":  cloudantSecurityObjectSchema("Names and roles of the database administrators."),
+			"members": cloudantSecurityObjectSchema("Names and roles of the database members."),
+			"cloudant": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Database permissions of Cloudant legacy credentials and API keys.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The user name or API key, or nobody for unauthenticated access.",
+						},
+						"roles": &schema.Schema{
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The roles of the principal, such as _reader, _writer, _replicator and _admin.",
+						},
+					},
+				},
+			},
+			"couchdb_auth_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage permissions using the admins and members only.",
+			},
+		},
+	}
+}
+
+func cloudantSecurityObjectSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"names": &schema.Schema{
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of usernames.",
+				},
+				"roles": &schema.Schema{
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of roles.",
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantDatabaseSecurityCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	dbName := d.Get("db").(string)
+
+	if err := putCloudantDatabaseSecurity(context, d, meta, instanceCRN, dbName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, dbName))
+
+	return resourceIBMCloudantDatabaseSecurityRead(context, d, meta)
+}
+
+func resourceIBMCloudantDatabaseSecurityRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecurityOptions := cloudantClient.NewGetSecurityOptions(dbName)
+
+	security, response, err := cloudantClient.GetSecurityWithContext(context, getSecurityOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecurityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecurityWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+
+	if err = d.Set("admins", flattenCloudantSecurityObject(security.Admins)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting admins: %s", err))
+	}
+
+	if err = d.Set("members", flattenCloudantSecurityObject(security.Members)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting members: %s", err))
+	}
+
+	principals := make([]string, 0, len(security.Cloudant))
+	for principal := range security.Cloudant {
+		principals = append(principals, principal)
+	}
+	sort.Strings(principals)
+	cloudantPermissions := []map[string]interface{}{}
+	for _, principal := range principals {
+		cloudantPermissions = append(cloudantPermissions, map[string]interface{}{
+			"principal": principal,
+			"roles":     security.Cloudant[principal],
+		})
+	}
+	if err = d.Set("cloudant", cloudantPermissions); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cloudant: %s", err))
+	}
+
+	if err = d.Set("couchdb_auth_only", security.CouchdbAuthOnly != nil && *security.CouchdbAuthOnly); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting couchdb_auth_only: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantDatabaseSecurityUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	if err = putCloudantDatabaseSecurity(context, d, meta, instanceCRN, dbName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMCloudantDatabaseSecurityRead(context, d, meta)
+}
+
+func resourceIBMCloudantDatabaseSecurityDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// An empty security document restores the default permissions of the database.
+	putSecurityOptions := &cloudantv1.PutSecurityOptions{
+		Db:      &dbName,
+		Admins:  &cloudantv1.SecurityObject{},
+		Members: &cloudantv1.SecurityObject{},
+	}
+
+	_, response, err := cloudantClient.PutSecurityWithContext(context, putSecurityOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] PutSecurityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutSecurityWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func putCloudantDatabaseSecurity(context context.Context, d *schema.ResourceData, meta interface{}, instanceCRN, dbName string) error {
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return err
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return err
+	}
+
+	// The security document is replaced as a whole, so omitted objects are sent empty.
+	putSecurityOptions := &cloudantv1.PutSecurityOptions{
+		Db:              &dbName,
+		Admins:          expandCloudantSecurityObject(d.Get("admins").([]interface{})),
+		Members:         expandCloudantSecurityObject(d.Get("members").([]interface{})),
+		CouchdbAuthOnly: core.BoolPtr(d.Get("couchdb_auth_only").(bool)),
+	}
+	if permissions := d.Get("cloudant").(*schema.Set).List(); len(permissions) > 0 {
+		putSecurityOptions.Cloudant = map[string][]string{}
+		for _, p := range permissions {
+			permission := p.(map[string]interface{})
+			putSecurityOptions.Cloudant[permission["principal"].(string)] = flex.ExpandStringList(permission["roles"].(*schema.Set).List())
+		}
+	}
+
+	_, response, err := cloudantClient.PutSecurityWithContext(context, putSecurityOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutSecurityWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("PutSecurityWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func expandCloudantSecurityObject(l []interface{}) *cloudantv1.SecurityObject {
+	securityObject := &cloudantv1.SecurityObject{
+		Names: []string{},
+		Roles: []string{},
+	}
+	if len(l) == 0 || l[0] == nil {
+		return securityObject
+	}
+	m := l[0].(map[string]interface{})
+	securityObject.Names = flex.ExpandStringList(m["names"].(*schema.Set).List())
+	securityObject.Roles = flex.ExpandStringList(m["roles"].(*schema.Set).List())
+	return securityObject
+}
+
+func flattenCloudantSecurityObject(securityObject *cloudantv1.SecurityObject) []map[string]interface{} {
+	if securityObject == nil || (len(securityObject.Names) == 0 && len(securityObject.Roles) == 0) {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{
+		{
+			"names": securityObject.Names,
+			"roles": securityObject.Roles,
+		},
+	}
+}
@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantDesignDocument() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantDesignDocumentCreate,
+		ReadContext:   resourceIBMCloudantDesignDocumentRead,
+		UpdateContext: resourceIBMCloudantDesignDocumentUpdate,
+		DeleteContext: resourceIBMCloudantDesignDocumentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the database name.",
+			},
+			"ddoc": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the design document, without the _design/ prefix.",
+			},
+			"language": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "javascript",
+				Description: "Language of the functions of the design document.",
+			},
+			"partitioned": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Whether the views and indexes of the design document are partitioned.",
+			},
+			"views": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "MapReduce views of the design document.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the view.",
+						},
+						"map": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "JavaScript map function of the view.",
+						},
+						"reduce": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "JavaScript reduce function of the view, or the name of a built-in reduce function such as _count.",
+						},
+					},
+				},
+			},
+			"search_indexes": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Search indexes of the design document.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the search index.",
+						},
+						"index": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "JavaScript function that indexes the documents.",
+						},
+						"analyzer": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the analyzer of the search index.",
+						},
+					},
+				},
+			},
+			"validate_doc_update": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "JavaScript function that validates the document updates of the database.",
+			},
+			"rev": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Revision of the design document.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantDesignDocumentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dbName := d.Get("db").(string)
+	ddoc := d.Get("ddoc").(string)
+	putDesignDocumentOptions := cloudantClient.NewPutDesignDocumentOptions(dbName, ddoc, expandCloudantDesignDocument(d))
+
+	_, response, err := cloudantClient.PutDesignDocumentWithContext(context, putDesignDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceCRN, dbName, ddoc))
+
+	return resourceIBMCloudantDesignDocumentRead(context, d, meta)
+}
+
+func resourceIBMCloudantDesignDocumentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, err := cloudantDesignDocumentIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getDesignDocumentOptions := cloudantClient.NewGetDesignDocumentOptions(dbName, ddoc)
+
+	designDocument, response, err := cloudantClient.GetDesignDocumentWithContext(context, getDesignDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+	d.Set("ddoc", ddoc)
+
+	if designDocument.Language != nil {
+		if err = d.Set("language", *designDocument.Language); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting language: %s", err))
+		}
+	}
+
+	partitioned := designDocument.Options != nil && designDocument.Options.Partitioned != nil && *designDocument.Options.Partitioned
+	if err = d.Set("partitioned", partitioned); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting partitioned: %s", err))
+	}
+
+	views := []map[string]interface{}{}
+	for name, view := range designDocument.Views {
+		views = append(views, map[string]interface{}{
+			"name":   name,
+			"map":    core.StringNilMapper(view.Map),
+			"reduce": core.StringNilMapper(view.Reduce),
+		})
+	}
+	if err = d.Set("views", views); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting views: %s", err))
+	}
+
+	searchIndexes := []map[string]interface{}{}
+	for name, index := range designDocument.Indexes {
+		searchIndex := map[string]interface{}{
+			"name":  name,
+			"index": core.StringNilMapper(index.Index),
+		}
+		if index.Analyzer != nil {
+			searchIndex["analyzer"] = core.StringNilMapper(index.Analyzer.Name)
+		}
+		searchIndexes = append(searchIndexes, searchIndex)
+	}
+	if err = d.Set("search_indexes", searchIndexes); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting search_indexes: %s", err))
+	}
+
+	if err = d.Set("validate_doc_update", core.StringNilMapper(designDocument.ValidateDocUpdate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting validate_doc_update: %s", err))
+	}
+
+	if err = d.Set("rev", core.StringNilMapper(designDocument.Rev)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rev: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantDesignDocumentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, err := cloudantDesignDocumentIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The whole design document is replaced, the revision guards against concurrent changes.
+	designDocument := expandCloudantDesignDocument(d)
+	designDocument.Rev = flex.PtrToString(d.Get("rev").(string))
+	putDesignDocumentOptions := cloudantClient.NewPutDesignDocumentOptions(dbName, ddoc, designDocument)
+
+	_, response, err := cloudantClient.PutDesignDocumentWithContext(context, putDesignDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	return resourceIBMCloudantDesignDocumentRead(context, d, meta)
+}
+
+func resourceIBMCloudantDesignDocumentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, err := cloudantDesignDocumentIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteDesignDocumentOptions := cloudantClient.NewDeleteDesignDocumentOptions(dbName, ddoc)
+	deleteDesignDocumentOptions.SetRev(d.Get("rev").(string))
+
+	_, response, err := cloudantClient.DeleteDesignDocumentWithContext(context, deleteDesignDocumentOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// cloudantDesignDocumentIdParts splits an ID of the form <instance_crn>/<db>/<ddoc>.
+func cloudantDesignDocumentIdParts(id string) (instanceCRN, dbName, ddoc string, err error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return
+	}
+	if len(parts) < 3 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of instance_crn/db/ddoc", id)
+		return
+	}
+	n := len(parts)
+	return strings.Join(parts[:n-2], "/"), parts[n-2], parts[n-1], nil
+}
+
+func expandCloudantDesignDocument(d *schema.ResourceData) *cloudantv1.DesignDocument {
+	designDocument := &cloudantv1.DesignDocument{
+		Language: flex.PtrToString(d.Get("language").(string)),
+	}
+	if partitioned, ok := d.GetOkExists("partitioned"); ok {
+		designDocument.Options = &cloudantv1.DesignDocumentOptions{
+			Partitioned: core.BoolPtr(partitioned.(bool)),
+		}
+	}
+	if views := d.Get("views").(*schema.Set).List(); len(views) > 0 {
+		designDocument.Views = map[string]cloudantv1.DesignDocumentViewsMapReduce{}
+		for _, v := range views {
+			view := v.(map[string]interface{})
+			mapReduce := cloudantv1.DesignDocumentViewsMapReduce{
+				Map: flex.PtrToString(view["map"].(string)),
+			}
+			if view["reduce"].(string) != "" {
+				mapReduce.Reduce = flex.PtrToString(view["reduce"].(string))
+			}
+			designDocument.Views[view["name"].(string)] = mapReduce
+		}
+	}
+	if indexes := d.Get("search_indexes").(*schema.Set).List(); len(indexes) > 0 {
+		designDocument.Indexes = map[string]cloudantv1.SearchIndexDefinition{}
+		for _, i := range indexes {
+			index := i.(map[string]interface{})
+			searchIndex := cloudantv1.SearchIndexDefinition{
+				Index: flex.PtrToString(index["index"].(string)),
+			}
+			if index["analyzer"].(string) != "" {
+				searchIndex.Analyzer = &cloudantv1.AnalyzerConfiguration{
+					Name: flex.PtrToString(index["analyzer"].(string)),
+				}
+			}
+			designDocument.Indexes[index["name"].(string)] = searchIndex
+		}
+	}
+	if validateDocUpdate, ok := d.GetOk("validate_doc_update"); ok {
+		designDocument.ValidateDocUpdate = flex.PtrToString(validateDocUpdate.(string))
+	}
+	return designDocument
+}
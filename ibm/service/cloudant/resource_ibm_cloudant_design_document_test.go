@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cloudant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCloudantDesignDocumentBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDesignDocumentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDesignDocumentConfig(instanceName, db, "_count"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_design_document.cloudant_design_document", "ddoc", "orders"),
+					resource.TestCheckResourceAttr("ibm_cloudant_design_document.cloudant_design_document", "views.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_design_document.cloudant_design_document", "rev"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDesignDocumentConfig(instanceName, db, "_sum"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_design_document.cloudant_design_document", "views.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cloudant_design_document.cloudant_design_document", "search_indexes.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cloudant_design_document.cloudant_design_document",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantDesignDocumentConfig(instanceName, db, reduce string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_design_document" "cloudant_design_document" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = ibm_cloudant_database.cloudant_database.db
+			ddoc = "orders"
+			views {
+				name = "by-customer"
+				map = "function (doc) { if (doc.type === 'order') { emit(doc.customer, doc.total); } }"
+				reduce = "%s"
+			}
+			search_indexes {
+				name = "by-text"
+				index = "function (doc) { index('default', doc.description); }"
+				analyzer = "standard"
+			}
+		}
+	`, instanceName, db, reduce)
+}
+
+func testAccCheckIBMCloudantDesignDocumentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_design_document" {
+			continue
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			// The instance is deleted with the rest of the configuration.
+			continue
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getDesignDocumentOptions := cloudantClient.NewGetDesignDocumentOptions(rs.Primary.Attributes["db"], rs.Primary.Attributes["ddoc"])
+
+		_, _, err = cloudantClient.GetDesignDocument(getDesignDocumentOptions)
+		if err == nil {
+			return fmt.Errorf("cloudant_design_document still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+func DataSourceIBMCbrRuleReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrRuleReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the account that owns the rules. Defaults to the account of the provider.",
+			},
+			"service_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The `serviceName` resource attribute of the accessed resource.",
+			},
+			"service_instance": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The `serviceInstance` resource attribute of the accessed resource.",
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The `region` resource attribute of the accessed resource.",
+			},
+			"resource_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The `resourceType` resource attribute of the accessed resource.",
+			},
+			"resource": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The `resource` resource attribute of the accessed resource.",
+			},
+			"api_type_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The API type of the operation. Rules without operations apply to every API type.",
+			},
+			"ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"ip_address", "vpc"},
+				Description:  "The IP address the request comes from.",
+			},
+			"vpc": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"ip_address", "vpc"},
+				Description:  "The CRN of the VPC the request comes from.",
+			},
+			"endpoint_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "The endpoint type the request is sent to. If not set, the endpoint type of the rules is not checked.",
+			},
+			"allowed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the request would be allowed by the enforced rules.",
+			},
+			"decision": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The decision for the request, `allow` or `deny`.",
+			},
+			"applicable_rule_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the enforced rules that apply to the resource and operation.",
+			},
+			"matched_rule_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the enforced rules whose contexts match the request.",
+			},
+			"report_only_rule_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the rules in report mode that apply to the resource and operation and whose contexts do not match the request.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCbrRuleReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	listRulesOptions := &contextbasedrestrictionsv1.ListRulesOptions{}
+	listRulesOptions.SetAccountID(accountID)
+	listRulesOptions.SetServiceName(d.Get("service_name").(string))
+
+	ruleList, response, err := contextBasedRestrictionsClient.ListRulesWithContext(context, listRulesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListRulesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListRulesWithContext failed %s\n%s", err, response))
+	}
+
+	target := map[string]string{
+		"accountId":       accountID,
+		"serviceName":     d.Get("service_name").(string),
+		"serviceInstance": d.Get("service_instance").(string),
+		"region":          d.Get("region").(string),
+		"resourceType":    d.Get("resource_type").(string),
+		"resource":        d.Get("resource").(string),
+	}
+	apiTypeID := d.Get("api_type_id").(string)
+	ipAddress := d.Get("ip_address").(string)
+	vpc := d.Get("vpc").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	var ip net.IP
+	if ipAddress != "" {
+		ip = net.ParseIP(ipAddress)
+		if ip == nil {
+			return diag.FromErr(fmt.Errorf("Invalid ip_address %s", ipAddress))
+		}
+	}
+
+	zones := map[string]*contextbasedrestrictionsv1.Zone{}
+	getZone := func(zoneID string) (*contextbasedrestrictionsv1.Zone, error) {
+		if zone, ok := zones[zoneID]; ok {
+			return zone, nil
+		}
+		getZoneOptions := &contextbasedrestrictionsv1.GetZoneOptions{}
+		getZoneOptions.SetZoneID(zoneID)
+		zone, response, err := contextBasedRestrictionsClient.GetZoneWithContext(context, getZoneOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetZoneWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("GetZoneWithContext failed %s\n%s", err, response)
+		}
+		zones[zoneID] = zone
+		return zone, nil
+	}
+
+	applicable := []string{}
+	matched := []string{}
+	reportOnly := []string{}
+	for _, rule := range ruleList.Rules {
+		enforcementMode := contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst
+		if rule.EnforcementMode != nil {
+			enforcementMode = *rule.EnforcementMode
+		}
+		if enforcementMode == contextbasedrestrictionsv1.RuleEnforcementModeDisabledConst {
+			continue
+		}
+		if !cbrRuleReportResourcesMatch(rule.Resources, target) || !cbrRuleReportOperationsMatch(rule.Operations, apiTypeID) {
+			continue
+		}
+
+		contextMatched := false
+		for _, ruleContext := range rule.Contexts {
+			ok, err := cbrRuleReportContextMatch(ruleContext, ip, vpc, endpointType, getZone)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if ok {
+				contextMatched = true
+				break
+			}
+		}
+
+		if enforcementMode == contextbasedrestrictionsv1.RuleEnforcementModeReportConst {
+			if !contextMatched {
+				reportOnly = append(reportOnly, *rule.ID)
+			}
+			continue
+		}
+		applicable = append(applicable, *rule.ID)
+		if contextMatched {
+			matched = append(matched, *rule.ID)
+		}
+	}
+
+	// A request to a resource that is protected by several rules is allowed
+	// when it matches at least one of them.
+	allowed := len(applicable) == 0 || len(matched) > 0
+	decision := "deny"
+	if allowed {
+		decision = "allow"
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	}
+	if err = d.Set("allowed", allowed); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allowed: %s", err))
+	}
+	if err = d.Set("decision", decision); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting decision: %s", err))
+	}
+	if err = d.Set("applicable_rule_ids", applicable); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting applicable_rule_ids: %s", err))
+	}
+	if err = d.Set("matched_rule_ids", matched); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting matched_rule_ids: %s", err))
+	}
+	if err = d.Set("report_only_rule_ids", reportOnly); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_only_rule_ids: %s", err))
+	}
+
+	return nil
+}
+
+// cbrRuleReportResourcesMatch reports whether one of the rule resources
+// covers the target, that is every attribute of the resource is set to the
+// same value on the target. Resource tags are not evaluated.
+func cbrRuleReportResourcesMatch(resources []contextbasedrestrictionsv1.Resource, target map[string]string) bool {
+	for _, resource := range resources {
+		covered := true
+		for _, attribute := range resource.Attributes {
+			value, ok := target[*attribute.Name]
+			if !ok || value != *attribute.Value {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return true
+		}
+	}
+	return false
+}
+
+func cbrRuleReportOperationsMatch(operations *contextbasedrestrictionsv1.NewRuleOperations, apiTypeID string) bool {
+	if operations == nil || len(operations.APITypes) == 0 || apiTypeID == "" {
+		return true
+	}
+	for _, apiType := range operations.APITypes {
+		if *apiType.APITypeID == apiTypeID {
+			return true
+		}
+	}
+	return false
+}
+
+// cbrRuleReportContextMatch reports whether the request satisfies the
+// network zone and endpoint type attributes of a rule context. Other context
+// attributes are not evaluated.
+func cbrRuleReportContextMatch(ruleContext contextbasedrestrictionsv1.RuleContext, ip net.IP, vpc, endpointType string, getZone func(string) (*contextbasedrestrictionsv1.Zone, error)) (bool, error) {
+	for _, attribute := range ruleContext.Attributes {
+		switch *attribute.Name {
+		case "endpointType":
+			if endpointType != "" && !cbrRuleReportListContains(*attribute.Value, endpointType) {
+				return false, nil
+			}
+		case "networkZoneId":
+			inZone := false
+			for _, zoneID := range strings.Split(*attribute.Value, ",") {
+				zone, err := getZone(strings.TrimSpace(zoneID))
+				if err != nil {
+					return false, err
+				}
+				if cbrRuleReportAddressesMatch(zone.Addresses, ip, vpc) && !cbrRuleReportAddressesMatch(zone.Excluded, ip, vpc) {
+					inZone = true
+					break
+				}
+			}
+			if !inZone {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// cbrRuleReportAddressesMatch reports whether the IP address or the VPC of the
+// request is in one of the addresses. Service references are not evaluated.
+func cbrRuleReportAddressesMatch(addresses []contextbasedrestrictionsv1.AddressIntf, ip net.IP, vpc string) bool {
+	for _, address := range addresses {
+		switch a := address.(type) {
+		case *contextbasedrestrictionsv1.AddressIPAddress:
+			if ip != nil && ip.Equal(net.ParseIP(*a.Value)) {
+				return true
+			}
+		case *contextbasedrestrictionsv1.AddressIPAddressRange:
+			bounds := strings.SplitN(*a.Value, "-", 2)
+			if ip != nil && len(bounds) == 2 {
+				first, last := net.ParseIP(bounds[0]), net.ParseIP(bounds[1])
+				if first != nil && last != nil && bytes.Compare(ip.To16(), first.To16()) >= 0 && bytes.Compare(ip.To16(), last.To16()) <= 0 {
+					return true
+				}
+			}
+		case *contextbasedrestrictionsv1.AddressSubnet:
+			_, subnet, err := net.ParseCIDR(*a.Value)
+			if ip != nil && err == nil && subnet.Contains(ip) {
+				return true
+			}
+		case *contextbasedrestrictionsv1.AddressVPC:
+			if vpc != "" && *a.Value == vpc {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func cbrRuleReportListContains(list, value string) bool {
+	for _, item := range strings.Split(list, ",") {
+		if strings.TrimSpace(item) == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCbrRuleReportDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleReportDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.inside", "decision", "allow"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.inside", "report_only_rule_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.excluded", "decision", "allow"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.excluded", "report_only_rule_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_cbr_rule_report.excluded", "report_only_rule_ids.0", "ibm_cbr_rule.cbr_rule", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrRuleReportDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "Test Rule Report Data Source Config Basic"
+			description = "Test Rule Report Data Source Config Basic"
+			account_id = "12ab34cd56ef78ab90cd12ef34ab56cd"
+			addresses {
+				type = "ipRange"
+				value = "169.23.22.0-169.23.22.255"
+			}
+			excluded {
+				type = "ipAddress"
+				value = "169.23.22.10"
+			}
+		}
+		resource "ibm_cbr_rule" "cbr_rule" {
+			description = "Test Rule Report Data Source Config Basic"
+			contexts {
+				attributes {
+					name = "networkZoneId"
+					value = ibm_cbr_zone.cbr_zone.id
+				}
+			}
+			resources {
+				attributes {
+					name = "accountId"
+					value = "12ab34cd56ef78ab90cd12ef34ab56cd"
+				}
+				attributes {
+					name = "serviceName"
+					value = "iam-groups"
+				}
+			}
+			enforcement_mode = "report"
+		}
+		data "ibm_cbr_rule_report" "inside" {
+			account_id = "12ab34cd56ef78ab90cd12ef34ab56cd"
+			service_name = ibm_cbr_rule.cbr_rule.resources[0].attributes[1].value
+			ip_address = "169.23.22.20"
+		}
+		data "ibm_cbr_rule_report" "excluded" {
+			account_id = "12ab34cd56ef78ab90cd12ef34ab56cd"
+			service_name = ibm_cbr_rule.cbr_rule.resources[0].attributes[1].value
+			ip_address = "169.23.22.10"
+		}
+	`)
+}
@@ -0,0 +1,447 @@
+package cos
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketWebsiteConfigurationCreate,
+		Read:     resourceIBMCOSBucketWebsiteConfigurationRead,
+		Update:   resourceIBMCOSBucketWebsiteConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^crn:.+:bucket:[^:]+$"), "must be the CRN of a COS bucket"),
+				Description:  "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"website_configuration": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration for hosting a static website on the COS bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_document": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "The object that is returned when an error occurs.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The object key name to use when a 4XX class error occurs.",
+									},
+								},
+							},
+						},
+						"index_document": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "The object that is returned for requests to a directory.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"suffix": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The suffix that is appended to requests for a directory, for example index.html.",
+									},
+								},
+							},
+						},
+						"redirect_all_requests_to": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"website_configuration.0.index_document", "website_configuration.0.error_document", "website_configuration.0.routing_rule"},
+							ExactlyOneOf:  []string{"website_configuration.0.redirect_all_requests_to", "website_configuration.0.index_document"},
+							Description:   "Redirects every request to the website endpoint of the bucket to another host.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the host where requests are redirected.",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+										Description:  "The protocol to use when redirecting requests: http, https. Defaults to the protocol of the original request.",
+									},
+								},
+							},
+						},
+						"routing_rule": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "Rules that define when a redirect is applied and the redirect behavior.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "The condition that must be met for the redirect to apply.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"http_error_code_returned_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The HTTP error code that must be returned for the redirect to apply.",
+												},
+												"key_prefix_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The object key name prefix that must match for the redirect to apply.",
+												},
+											},
+										},
+									},
+									"redirect": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "The redirect to apply when the condition is met.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"host_name": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The host name to use in the redirect request.",
+												},
+												"http_redirect_code": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The HTTP redirect code to use on the response.",
+												},
+												"protocol": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+													Description:  "The protocol to use when redirecting requests: http, https.",
+												},
+												"replace_key_prefix_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The object key prefix that replaces the key_prefix_equals value of the condition.",
+												},
+												"replace_key_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The object key that replaces the requested key.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"website_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The website endpoint of the bucket.",
+			},
+		},
+	}
+}
+
+func websiteConfigurationSet(websiteList []interface{}) (*s3.WebsiteConfiguration, error) {
+	websiteConfig := &s3.WebsiteConfiguration{}
+	if len(websiteList) == 0 || websiteList[0] == nil {
+		return websiteConfig, nil
+	}
+	websiteMap := websiteList[0].(map[string]interface{})
+
+	//Error document
+	if errorDocSet, exist := websiteMap["error_document"]; exist {
+		for _, e := range errorDocSet.([]interface{}) {
+			errorDocMap := e.(map[string]interface{})
+			websiteConfig.ErrorDocument = &s3.ErrorDocument{Key: aws.String(errorDocMap["key"].(string))}
+		}
+	}
+	//Index document
+	if indexDocSet, exist := websiteMap["index_document"]; exist {
+		for _, i := range indexDocSet.([]interface{}) {
+			indexDocMap := i.(map[string]interface{})
+			websiteConfig.IndexDocument = &s3.IndexDocument{Suffix: aws.String(indexDocMap["suffix"].(string))}
+		}
+	}
+	//Redirect all requests
+	if redirectAllSet, exist := websiteMap["redirect_all_requests_to"]; exist {
+		for _, r := range redirectAllSet.([]interface{}) {
+			redirectAllMap := r.(map[string]interface{})
+			redirectAll := &s3.RedirectAllRequestsTo{HostName: aws.String(redirectAllMap["host_name"].(string))}
+			if protocol := redirectAllMap["protocol"].(string); protocol != "" {
+				redirectAll.Protocol = aws.String(protocol)
+			}
+			websiteConfig.RedirectAllRequestsTo = redirectAll
+		}
+	}
+	//Routing rules
+	if routingRuleSet, exist := websiteMap["routing_rule"]; exist {
+		for _, r := range routingRuleSet.([]interface{}) {
+			routingRuleMap := r.(map[string]interface{})
+			routingRule := &s3.RoutingRule{}
+			for _, c := range routingRuleMap["condition"].([]interface{}) {
+				if c == nil {
+					continue
+				}
+				conditionMap := c.(map[string]interface{})
+				condition := &s3.Condition{}
+				if errorCode := conditionMap["http_error_code_returned_equals"].(string); errorCode != "" {
+					condition.HttpErrorCodeReturnedEquals = aws.String(errorCode)
+				}
+				if keyPrefix := conditionMap["key_prefix_equals"].(string); keyPrefix != "" {
+					condition.KeyPrefixEquals = aws.String(keyPrefix)
+				}
+				routingRule.Condition = condition
+			}
+			for _, rd := range routingRuleMap["redirect"].([]interface{}) {
+				redirect := &s3.Redirect{}
+				if rd != nil {
+					redirectMap := rd.(map[string]interface{})
+					if hostName := redirectMap["host_name"].(string); hostName != "" {
+						redirect.HostName = aws.String(hostName)
+					}
+					if redirectCode := redirectMap["http_redirect_code"].(string); redirectCode != "" {
+						redirect.HttpRedirectCode = aws.String(redirectCode)
+					}
+					if protocol := redirectMap["protocol"].(string); protocol != "" {
+						redirect.Protocol = aws.String(protocol)
+					}
+					replaceKeyPrefix := redirectMap["replace_key_prefix_with"].(string)
+					replaceKey := redirectMap["replace_key_with"].(string)
+					if replaceKeyPrefix != "" && replaceKey != "" {
+						return nil, fmt.Errorf("only one of replace_key_prefix_with and replace_key_with can be set in a routing rule redirect")
+					}
+					if replaceKeyPrefix != "" {
+						redirect.ReplaceKeyPrefixWith = aws.String(replaceKeyPrefix)
+					}
+					if replaceKey != "" {
+						redirect.ReplaceKeyWith = aws.String(replaceKey)
+					}
+				}
+				routingRule.Redirect = redirect
+			}
+			websiteConfig.RoutingRules = append(websiteConfig.RoutingRules, routingRule)
+		}
+	}
+	return websiteConfig, nil
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	websiteConfig, err := websiteConfigurationSet(d.Get("website_configuration").([]interface{}))
+	if err != nil {
+		return err
+	}
+	putBucketWebsiteInput := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucketName),
+		WebsiteConfiguration: websiteConfig,
+	}
+
+	_, err = s3Client.PutBucketWebsite(putBucketWebsiteInput)
+
+	if err != nil {
+		return fmt.Errorf("failed to create the website configuration on COS bucket %s, %v", bucketName, err)
+	}
+
+	//Generating a fake id which contains every information about to get the bucket via s3 api
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+
+	return resourceIBMCOSBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("website_configuration") {
+		websiteConfig, err := websiteConfigurationSet(d.Get("website_configuration").([]interface{}))
+		if err != nil {
+			return err
+		}
+		putBucketWebsiteInput := &s3.PutBucketWebsiteInput{
+			Bucket:               aws.String(bucketName),
+			WebsiteConfiguration: websiteConfig,
+		}
+
+		_, err = s3Client.PutBucketWebsite(putBucketWebsiteInput)
+
+		if err != nil {
+			return fmt.Errorf("failed to update the website configuration on COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+
+	bucketCRN := parseBucketReplId(d.Id(), "bucketCRN")
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	getBucketWebsiteInput := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	websiteptr, err := s3Client.GetBucketWebsite(getBucketWebsiteInput)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchWebsiteConfiguration") {
+			d.SetId("")
+			return nil
+		}
+		if !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
+			return err
+		}
+		// The firewall of a bucket denies the access from IPs that are not allowed, any other
+		// denial is an error.
+		getBucketConfigOptions := &resourceconfigurationv1.GetBucketConfigOptions{
+			Bucket: &bucketName,
+		}
+		sess, sessErr := meta.(conns.ClientSession).CosConfigV1API()
+		if sessErr != nil {
+			return sessErr
+		}
+		if endpointType == "private" {
+			sess.SetServiceURL("https://config.private.cloud-object-storage.cloud.ibm.com/v1")
+		}
+		bucketPtr, response, configErr := sess.GetBucketConfig(getBucketConfigOptions)
+		if configErr != nil {
+			return fmt.Errorf("[ERROR] Error in getting bucket info rule: %s\n%s", configErr, response)
+		}
+		if bucketPtr == nil || bucketPtr.Firewall == nil {
+			return err
+		}
+	}
+
+	if websiteptr != nil {
+		d.Set("website_configuration", flex.WebsiteConfigurationGet(websiteptr))
+	}
+	d.Set("website_endpoint", fmt.Sprintf("%s.s3-web.%s.cloud-object-storage.appdomain.cloud", bucketName, bucketLocation))
+
+	return nil
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseBucketReplId(d.Id(), "bucketName")
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	instanceCRN := parseBucketReplId(d.Id(), "instanceCRN")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	deleteBucketWebsiteInput := &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	_, err = s3Client.DeleteBucketWebsite(deleteBucketWebsiteInput)
+
+	if err != nil {
+		return err
+	}
+	return nil
+}
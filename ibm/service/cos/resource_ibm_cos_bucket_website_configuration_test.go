@@ -0,0 +1,111 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCosBucket_Website_Configuration(t *testing.T) {
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-testacc-website-%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_website(cosServiceName, bucketName, bucketRegion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.cos_bucket", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "website_configuration.0.index_document.0.suffix", "index.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "website_configuration.0.routing_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "website_configuration.0.routing_rule.0.redirect.0.replace_key_with", "index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_website_configuration.website", "website_endpoint"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_website_redirectAll(cosServiceName, bucketName, bucketRegion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "website_configuration.0.redirect_all_requests_to.0.host_name", "example.com"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "website_configuration.0.redirect_all_requests_to.0.protocol", "https"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "website_configuration.0.routing_rule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_website_configuration.website",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_websiteBase(cosServiceName string, bucketName string, region string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		is_default=true
+	}
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+
+	resource "ibm_cos_bucket" "cos_bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "standard"
+	}
+	`, cosServiceName, bucketName, region)
+}
+
+// single page application: serve index.html for every missing key
+func testAccCheckIBMCosBucket_website(cosServiceName string, bucketName string, region string) string {
+	return testAccCheckIBMCosBucket_websiteBase(cosServiceName, bucketName, region) + `
+	resource "ibm_cos_bucket_website_configuration" "website" {
+		bucket_crn      = ibm_cos_bucket.cos_bucket.crn
+		bucket_location = ibm_cos_bucket.cos_bucket.region_location
+		website_configuration {
+			index_document {
+				suffix = "index.html"
+			}
+			error_document {
+				key = "error.html"
+			}
+			routing_rule {
+				condition {
+					http_error_code_returned_equals = "404"
+				}
+				redirect {
+					replace_key_with = "index.html"
+				}
+			}
+		}
+	}
+	`
+}
+
+func testAccCheckIBMCosBucket_website_redirectAll(cosServiceName string, bucketName string, region string) string {
+	return testAccCheckIBMCosBucket_websiteBase(cosServiceName, bucketName, region) + `
+	resource "ibm_cos_bucket_website_configuration" "website" {
+		bucket_crn      = ibm_cos_bucket.cos_bucket.crn
+		bucket_location = ibm_cos_bucket.cos_bucket.region_location
+		website_configuration {
+			redirect_all_requests_to {
+				host_name = "example.com"
+				protocol  = "https"
+			}
+		}
+	}
+	`
+}
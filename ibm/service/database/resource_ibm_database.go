@@ -1444,7 +1444,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	}
 	d.SetId(*instance.ID)
 
-	_, err = waitForDatabaseInstanceCreate(d, meta, *instance.ID)
+	_, err = waitForDatabaseInstanceCreate(context, d, meta, *instance.ID)
 	if err != nil {
 		return diag.FromErr(
 			fmt.Errorf(
@@ -1463,7 +1463,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 				return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
 			}
 
-			err = horizontalScale(d, meta, icdClient)
+			err = horizontalScale(context, d, meta, icdClient)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -1979,7 +1979,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
 		}
 
-		_, err = waitForDatabaseInstanceUpdate(d, meta)
+		_, err = waitForDatabaseInstanceUpdate(context, d, meta)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for update of resource instance (%s) to complete: %s", d.Id(), err))
@@ -2007,7 +2007,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	icdId := flex.EscapeUrlParm(instanceID)
 
 	if d.HasChange("node_count") {
-		err = horizontalScale(d, meta, icdClient)
+		err = horizontalScale(context, d, meta, icdClient)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -2436,7 +2436,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
-func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.ICDServiceAPI) error {
+func horizontalScale(context context.Context, d *schema.ResourceData, meta interface{}, icdClient icdv4.ICDServiceAPI) error {
 	params := icdv4.GroupReq{}
 
 	icdId := flex.EscapeUrlParm(d.Id())
@@ -2452,7 +2452,7 @@ func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.I
 	}
 
 	// ScaleOut is handled with an ICD API call, however, the check is is on the instance status
-	_, err = waitForDatabaseInstanceUpdate(d, meta)
+	_, err = waitForDatabaseInstanceUpdate(context, d, meta)
 	if err != nil {
 		return fmt.Errorf(
 			"[ERROR] Error waiting for database (%s) horizontal scale to complete: %s", d.Id(), err)
@@ -2564,7 +2564,7 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 		}
 	}
 
-	_, err = waitForDatabaseInstanceDelete(d, meta)
+	_, err = waitForDatabaseInstanceDelete(context, d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
@@ -2625,7 +2625,7 @@ func waitForICDReady(meta interface{}, instanceID string) error {
 	return nil
 }
 
-func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForDatabaseInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -2661,10 +2661,10 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -2701,7 +2701,7 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta interface{}, t time.Duration) (bool, error) {
@@ -2745,7 +2745,7 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 	}
 }
 
-func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -2775,7 +2775,7 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func filterDatabaseDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -16,6 +16,7 @@ const (
 	dlVirtualConnectionId          = "virtual_connection_id"
 	dlVirtualConnectionName        = "virtual_connection_name"
 	dlVirtualConnectionType        = "virtual_connection_type"
+	dlAction                       = "action"
 	dlActive                       = "active"
 	dlAsPrepends                   = "as_prepends"
 	dlAuthenticationKey            = "authentication_key"
@@ -0,0 +1,318 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	dlChangeRequestPending = "pending"
+	dlChangeRequestWaiting = "waiting"
+	dlChangeRequestDone    = "done"
+)
+
+func ResourceIBMDLGatewayAction() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMdlGatewayActionCreate,
+		Read:     resourceIBMdlGatewayActionRead,
+		Delete:   resourceIBMdlGatewayActionDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			dlGatewayId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the gateway with the change request that was created by the provider",
+			},
+			dlAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_dl_gateway_action", dlAction),
+				Description:  "Approve or reject the pending change request of the gateway",
+			},
+			dlGlobal: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Required for create_gateway_approve. Gateways with global routing (true) can connect to networks outside their associated region",
+			},
+			dlMetered: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Required for create_gateway_approve. Metered billing option",
+			},
+			dlResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The resource group of the gateway, only used by create_gateway_approve",
+			},
+			dlConnectionMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_dl_gateway_action", dlConnectionMode),
+				Description:  "Type of services this gateway is attached to, only used by create_gateway_approve",
+			},
+			dlSpeedMbps: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Gateway speed in megabits per second",
+			},
+			dlOperationalStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Gateway operational status",
+			},
+			dlChangeRequest: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the change request that is still pending on the gateway",
+			},
+		},
+	}
+}
+
+func ResourceIBMDLGatewayActionValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+	dlActionAllowedValues := "create_gateway_approve, create_gateway_reject, delete_gateway_approve, delete_gateway_reject, update_attributes_approve, update_attributes_reject"
+	dlConnectionModeAllowedValues := "direct, transit"
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              dlActionAllowedValues})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 dlConnectionMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              dlConnectionModeAllowedValues})
+
+	ibmDLGatewayActionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_dl_gateway_action", Schema: validateSchema}
+	return &ibmDLGatewayActionResourceValidator
+}
+
+func resourceIBMdlGatewayActionCreate(d *schema.ResourceData, meta interface{}) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+
+	gatewayID := d.Get(dlGatewayId).(string)
+	action := d.Get(dlAction).(string)
+	// The change request type is the action without its approve or reject suffix
+	changeRequestType := action[:strings.LastIndex(action, "_")]
+
+	instance, err := isWaitForDirectLinkChangeRequest(directLink, gatewayID, changeRequestType, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	gateway := instance.(*directlinkv1.Gateway)
+
+	actionOptions := directLink.NewCreateGatewayActionOptions(gatewayID, action)
+	if action == directlinkv1.CreateGatewayActionOptions_Action_CreateGatewayApprove {
+		global, globalOk := d.GetOkExists(dlGlobal)
+		metered, meteredOk := d.GetOkExists(dlMetered)
+		if !globalOk || !meteredOk {
+			return fmt.Errorf("[ERROR] %s and %s must be set to approve the creation of gateway %s", dlGlobal, dlMetered, gatewayID)
+		}
+		actionOptions.SetGlobal(global.(bool))
+		actionOptions.SetMetered(metered.(bool))
+		if resourceGroup, ok := d.GetOk(dlResourceGroup); ok {
+			actionOptions.SetResourceGroup(&directlinkv1.ResourceGroupIdentity{ID: flex.PtrToString(resourceGroup.(string))})
+		}
+		if connectionMode, ok := d.GetOk(dlConnectionMode); ok {
+			actionOptions.SetConnectionMode(connectionMode.(string))
+		}
+	}
+	if changeRequestType == "update_attributes" {
+		// The action must repeat the updates of the change request it approves or rejects
+		changeRequest := gateway.ChangeRequest.(*directlinkv1.GatewayChangeRequest)
+		updates := make([]directlinkv1.GatewayActionTemplateUpdatesItemIntf, 0, len(changeRequest.Updates))
+		for _, updateIntf := range changeRequest.Updates {
+			update, ok := updateIntf.(*directlinkv1.GatewayChangeRequestUpdatesItem)
+			if !ok {
+				continue
+			}
+			updates = append(updates, &directlinkv1.GatewayActionTemplateUpdatesItem{
+				SpeedMbps:  update.SpeedMbps,
+				BgpCerCidr: update.BgpCerCidr,
+				BgpIbmCidr: update.BgpIbmCidr,
+				BgpAsn:     update.BgpAsn,
+				Vlan:       update.Vlan,
+			})
+		}
+		actionOptions.SetUpdates(updates)
+	}
+
+	_, response, err := directLink.CreateGatewayAction(actionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] Create Direct Link Gateway Action err %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error performing action %s on Direct Link Gateway %s: %s\n%s", action, gatewayID, err, response)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", gatewayID, action))
+
+	_, err = isWaitForDirectLinkChangeRequestDone(directLink, gatewayID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	if action == directlinkv1.CreateGatewayActionOptions_Action_CreateGatewayApprove {
+		_, err = isWaitForDirectLinkAvailable(directLink, gatewayID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMdlGatewayActionRead(d, meta)
+}
+
+func resourceIBMdlGatewayActionRead(d *schema.ResourceData, meta interface{}) error {
+	directLink, err := directlinkClient(meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) != 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of gatewayID/action", d.Id())
+	}
+	gatewayID := parts[0]
+	action := parts[1]
+
+	getOptions := &directlinkv1.GetGatewayOptions{
+		ID: &gatewayID,
+	}
+	instance, response, err := directLink.GetGateway(getOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			// A rejected creation or an approved deletion removes the gateway, which is
+			// the expected outcome of the action rather than drift.
+			if action == directlinkv1.CreateGatewayActionOptions_Action_CreateGatewayReject || action == directlinkv1.CreateGatewayActionOptions_Action_DeleteGatewayApprove {
+				d.Set(dlGatewayId, gatewayID)
+				d.Set(dlAction, action)
+				d.Set(dlOperationalStatus, "deleted")
+				d.Set(dlChangeRequest, "")
+				return nil
+			}
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Getting Direct Link Gateway : %s\n%s", err, response)
+	}
+
+	d.Set(dlGatewayId, gatewayID)
+	d.Set(dlAction, action)
+	if instance.OperationalStatus != nil {
+		d.Set(dlOperationalStatus, *instance.OperationalStatus)
+	}
+	if instance.SpeedMbps != nil {
+		d.Set(dlSpeedMbps, *instance.SpeedMbps)
+	}
+	if instance.ResourceGroup != nil {
+		d.Set(dlResourceGroup, *instance.ResourceGroup.ID)
+	}
+	d.Set(dlChangeRequest, "")
+	if changeRequest, ok := instance.ChangeRequest.(*directlinkv1.GatewayChangeRequest); ok && changeRequest.Type != nil {
+		d.Set(dlChangeRequest, *changeRequest.Type)
+	}
+	return nil
+}
+
+func resourceIBMdlGatewayActionDelete(d *schema.ResourceData, meta interface{}) error {
+	// An approval or rejection cannot be undone, removing the resource only removes it from the state
+	d.SetId("")
+	return nil
+}
+
+func isWaitForDirectLinkChangeRequest(client *directlinkv1.DirectLinkV1, id, changeRequestType string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for direct link (%s) to have a %s change request.", id, changeRequestType)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dlChangeRequestWaiting},
+		Target:     []string{dlChangeRequestPending},
+		Refresh:    isDirectLinkChangeRequestRefreshFunc(client, id, changeRequestType),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func isDirectLinkChangeRequestRefreshFunc(client *directlinkv1.DirectLinkV1, id, changeRequestType string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getOptions := &directlinkv1.GetGatewayOptions{
+			ID: &id,
+		}
+		instance, response, err := client.GetGateway(getOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				// The provider has not created the gateway in this account yet
+				return id, dlChangeRequestWaiting, nil
+			}
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+		}
+		if changeRequest, ok := instance.ChangeRequest.(*directlinkv1.GatewayChangeRequest); ok && changeRequest.Type != nil && *changeRequest.Type == changeRequestType {
+			return instance, dlChangeRequestPending, nil
+		}
+		return instance, dlChangeRequestWaiting, nil
+	}
+}
+
+func isWaitForDirectLinkChangeRequestDone(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the change request of direct link (%s) to complete.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dlChangeRequestPending},
+		Target:     []string{dlChangeRequestDone},
+		Refresh:    isDirectLinkChangeRequestDoneRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func isDirectLinkChangeRequestDoneRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getOptions := &directlinkv1.GetGatewayOptions{
+			ID: &id,
+		}
+		instance, response, err := client.GetGateway(getOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return id, dlChangeRequestDone, nil
+			}
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+		}
+		if instance.ChangeRequest != nil {
+			return instance, dlChangeRequestPending, nil
+		}
+		return instance, dlChangeRequestDone, nil
+	}
+}
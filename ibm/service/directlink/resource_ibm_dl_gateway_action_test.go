@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDLGatewayAction_approveCreate(t *testing.T) {
+	// The gateway is created by a provider account with ibm_dl_provider_gateway
+	gatewayID := os.Getenv("IBM_DL_PROVIDER_GATEWAY_ID")
+	if gatewayID == "" {
+		t.Skip("Set IBM_DL_PROVIDER_GATEWAY_ID to a gateway with a pending create request to run this test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLGatewayActionConfig(gatewayID, "create_gateway_approve"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_dl_gateway_action.test_dl_gateway_action", "gateway", gatewayID),
+					resource.TestCheckResourceAttr("ibm_dl_gateway_action.test_dl_gateway_action", "operational_status", "provisioned"),
+					resource.TestCheckResourceAttr("ibm_dl_gateway_action.test_dl_gateway_action", "change_request", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLGatewayActionConfig(gatewayID, action string) string {
+	return fmt.Sprintf(`
+	  resource "ibm_dl_gateway_action" "test_dl_gateway_action" {
+		gateway = "%s"
+		action  = "%s"
+		global  = true
+		metered = false
+	  }
+	  `, gatewayID, action)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/directlinkproviderv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -99,6 +100,11 @@ func ResourceIBMDLProviderGateway() *schema.Resource {
 				Description: "Gateway type",
 				Computed:    true,
 			},
+			dlChangeRequest: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the change request that is pending the approval of the customer account",
+			},
 			dlProviderAPIManaged: {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -300,6 +306,10 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 	if instance.BgpStatus != nil {
 		d.Set(dlBgpStatus, *instance.BgpStatus)
 	}
+	d.Set(dlChangeRequest, "")
+	if changeRequest, ok := instance.ChangeRequest.(*directlinkproviderv2.ProviderGatewayChangeRequest); ok && changeRequest.Type != nil {
+		d.Set(dlChangeRequest, *changeRequest.Type)
+	}
 
 	if instance.Vlan != nil {
 		d.Set(dlVlan, *instance.Vlan)
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+)
+
+func DataSourceIBMEnterpriseBillingUnits() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmEnterpriseBillingUnitsRead,
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_id", "account_group_id"},
+				Description:  "The ID of the enterprise to list the billing units of.",
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_id", "account_group_id"},
+				Description:  "The ID of an enterprise account to list the billing units of.",
+			},
+			"account_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_id", "account_group_id"},
+				Description:  "The ID of an account group to list the billing units of.",
+			},
+			"billing_units": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of billing units.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the billing unit.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Cloud Resource Name (CRN) of the billing unit.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the billing unit.",
+						},
+						"enterprise_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the enterprise that the billing unit belongs to.",
+						},
+						"currency_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The currency code of the billing unit.",
+						},
+						"country_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The country code of the billing unit.",
+						},
+						"master": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the billing unit is the primary billing unit of the enterprise.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time stamp at which the billing unit was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmEnterpriseBillingUnitsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseBillingUnitsClient, err := meta.(conns.ClientSession).EnterpriseBillingUnitsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listBillingUnitsOptions := &enterprisebillingunitsv1.ListBillingUnitsOptions{}
+	var id string
+	if v, ok := d.GetOk("enterprise_id"); ok {
+		id = v.(string)
+		listBillingUnitsOptions.EnterpriseID = &id
+	}
+	if v, ok := d.GetOk("account_id"); ok {
+		id = v.(string)
+		listBillingUnitsOptions.AccountID = &id
+	}
+	if v, ok := d.GetOk("account_group_id"); ok {
+		id = v.(string)
+		listBillingUnitsOptions.AccountGroupID = &id
+	}
+
+	billingUnitsList, response, err := enterpriseBillingUnitsClient.ListBillingUnitsWithContext(context, listBillingUnitsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListBillingUnitsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	err = d.Set("billing_units", dataSourceEnterpriseBillingUnitsFlattenResources(billingUnitsList.Resources))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting billing_units %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceEnterpriseBillingUnitsFlattenResources(result []enterprisebillingunitsv1.BillingUnit) (resources []map[string]interface{}) {
+	resources = []map[string]interface{}{}
+	for _, resourcesItem := range result {
+		resourcesMap := map[string]interface{}{}
+		if resourcesItem.ID != nil {
+			resourcesMap["id"] = resourcesItem.ID
+		}
+		if resourcesItem.CRN != nil {
+			resourcesMap["crn"] = resourcesItem.CRN
+		}
+		if resourcesItem.Name != nil {
+			resourcesMap["name"] = resourcesItem.Name
+		}
+		if resourcesItem.EnterpriseID != nil {
+			resourcesMap["enterprise_id"] = resourcesItem.EnterpriseID
+		}
+		if resourcesItem.CurrencyCode != nil {
+			resourcesMap["currency_code"] = resourcesItem.CurrencyCode
+		}
+		if resourcesItem.CountryCode != nil {
+			resourcesMap["country_code"] = resourcesItem.CountryCode
+		}
+		if resourcesItem.Master != nil {
+			resourcesMap["master"] = resourcesItem.Master
+		}
+		if resourcesItem.CreatedAt != nil {
+			resourcesMap["created_at"] = flex.DateTimeToString(resourcesItem.CreatedAt)
+		}
+		resources = append(resources, resourcesMap)
+	}
+
+	return resources
+}
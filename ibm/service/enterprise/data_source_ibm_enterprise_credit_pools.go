@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+)
+
+func DataSourceIBMEnterpriseCreditPools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmEnterpriseCreditPoolsRead,
+
+		Schema: map[string]*schema.Schema{
+			"billing_unit_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the billing unit to get the credit pools of.",
+			},
+			"date": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The month of the credit pools in the format YYYY-MM. Defaults to the current month.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{
+					enterprisebillingunitsv1.CreditPoolTypePlatformConst,
+					enterprisebillingunitsv1.CreditPoolTypeSupportConst}),
+				Description: "The type of the credit pools, PLATFORM or SUPPORT.",
+			},
+			"credit_pools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of credit pools.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the credit pool.",
+						},
+						"currency_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The currency code of the credit pool.",
+						},
+						"billing_unit_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the billing unit of the credit pool.",
+						},
+						"term_credits": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The credits of the credit pool, one entry per subscription or commitment term.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"billing_option_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the billing option that the credits come from.",
+									},
+									"category": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The category of the billing option.",
+									},
+									"start_date": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The start date of the term.",
+									},
+									"end_date": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The end date of the term.",
+									},
+									"total_credits": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The total credits of the term.",
+									},
+									"starting_balance": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The balance of the term at the start of the month.",
+									},
+									"used_credits": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The credits used in the month.",
+									},
+									"current_balance": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The current balance of the term.",
+									},
+								},
+							},
+						},
+						"overage_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The cost that exceeds the credits of the credit pool.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmEnterpriseCreditPoolsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseBillingUnitsClient, err := meta.(conns.ClientSession).EnterpriseBillingUnitsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	billingUnitID := d.Get("billing_unit_id").(string)
+	getCreditPoolsOptions := &enterprisebillingunitsv1.GetCreditPoolsOptions{
+		BillingUnitID: &billingUnitID,
+	}
+	if v, ok := d.GetOk("date"); ok {
+		getCreditPoolsOptions.SetDate(v.(string))
+	}
+	if v, ok := d.GetOk("type"); ok {
+		getCreditPoolsOptions.SetType(v.(string))
+	}
+
+	creditPoolsList, response, err := enterpriseBillingUnitsClient.GetCreditPoolsWithContext(context, getCreditPoolsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetCreditPoolsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(billingUnitID)
+	err = d.Set("credit_pools", dataSourceEnterpriseCreditPoolsFlattenResources(creditPoolsList.Resources))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting credit_pools %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceEnterpriseCreditPoolsFlattenResources(result []enterprisebillingunitsv1.CreditPool) (resources []map[string]interface{}) {
+	resources = []map[string]interface{}{}
+	for _, resourcesItem := range result {
+		resourcesMap := map[string]interface{}{}
+		if resourcesItem.Type != nil {
+			resourcesMap["type"] = resourcesItem.Type
+		}
+		if resourcesItem.CurrencyCode != nil {
+			resourcesMap["currency_code"] = resourcesItem.CurrencyCode
+		}
+		if resourcesItem.BillingUnitID != nil {
+			resourcesMap["billing_unit_id"] = resourcesItem.BillingUnitID
+		}
+		termCredits := []map[string]interface{}{}
+		for _, termCreditsItem := range resourcesItem.TermCredits {
+			termCreditsMap := map[string]interface{}{}
+			if termCreditsItem.BillingOptionID != nil {
+				termCreditsMap["billing_option_id"] = termCreditsItem.BillingOptionID
+			}
+			if termCreditsItem.Category != nil {
+				termCreditsMap["category"] = termCreditsItem.Category
+			}
+			if termCreditsItem.StartDate != nil {
+				termCreditsMap["start_date"] = flex.DateTimeToString(termCreditsItem.StartDate)
+			}
+			if termCreditsItem.EndDate != nil {
+				termCreditsMap["end_date"] = flex.DateTimeToString(termCreditsItem.EndDate)
+			}
+			if termCreditsItem.TotalCredits != nil {
+				termCreditsMap["total_credits"] = termCreditsItem.TotalCredits
+			}
+			if termCreditsItem.StartingBalance != nil {
+				termCreditsMap["starting_balance"] = termCreditsItem.StartingBalance
+			}
+			if termCreditsItem.UsedCredits != nil {
+				termCreditsMap["used_credits"] = termCreditsItem.UsedCredits
+			}
+			if termCreditsItem.CurrentBalance != nil {
+				termCreditsMap["current_balance"] = termCreditsItem.CurrentBalance
+			}
+			termCredits = append(termCredits, termCreditsMap)
+		}
+		resourcesMap["term_credits"] = termCredits
+		if resourcesItem.Overage != nil && resourcesItem.Overage.Cost != nil {
+			resourcesMap["overage_cost"] = resourcesItem.Overage.Cost
+		}
+		resources = append(resources, resourcesMap)
+	}
+
+	return resources
+}
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+)
+
+func DataSourceIBMEnterpriseUsageReports() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmEnterpriseUsageReportsRead,
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_group_id", "account_id"},
+				Description:  "The ID of the enterprise to get the usage of.",
+			},
+			"account_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_group_id", "account_id"},
+				Description:  "The ID of the account group to get the usage of.",
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_group_id", "account_id"},
+				Description:  "The ID of the account to get the usage of.",
+			},
+			"children": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to get the usage of the direct children of the enterprise or account group instead of its own usage.",
+			},
+			"month": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The month of the usage in the format YYYY-MM. Defaults to the current month.",
+			},
+			"billing_unit_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the billing unit to filter the usage by.",
+			},
+			"reports": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of usage reports.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the enterprise, account group or account of the report.",
+						},
+						"entity_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the entity of the report.",
+						},
+						"entity_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the entity of the report.",
+						},
+						"entity_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the entity of the report.",
+						},
+						"billing_unit_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the billing unit of the entity.",
+						},
+						"billing_unit_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the billing unit of the entity.",
+						},
+						"billing_unit_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the billing unit of the entity.",
+						},
+						"country_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The country code of the billing unit.",
+						},
+						"currency_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The currency code of the billing unit.",
+						},
+						"month": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The month of the report.",
+						},
+						"billable_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The billable charges of the entity, after discounts.",
+						},
+						"non_billable_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The non-billable charges of the entity, after discounts.",
+						},
+						"billable_rated_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The billable charges of the entity, before discounts.",
+						},
+						"non_billable_rated_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The non-billable charges of the entity, before discounts.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmEnterpriseUsageReportsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseUsageReportsClient, err := meta.(conns.ClientSession).EnterpriseUsageReportsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getResourceUsageReportOptions := &enterpriseusagereportsv1.GetResourceUsageReportOptions{}
+	if v, ok := d.GetOk("enterprise_id"); ok {
+		getResourceUsageReportOptions.SetEnterpriseID(v.(string))
+	}
+	if v, ok := d.GetOk("account_group_id"); ok {
+		getResourceUsageReportOptions.SetAccountGroupID(v.(string))
+	}
+	if v, ok := d.GetOk("account_id"); ok {
+		getResourceUsageReportOptions.SetAccountID(v.(string))
+	}
+	getResourceUsageReportOptions.SetChildren(d.Get("children").(bool))
+	if v, ok := d.GetOk("month"); ok {
+		getResourceUsageReportOptions.SetMonth(v.(string))
+	}
+	if v, ok := d.GetOk("billing_unit_id"); ok {
+		getResourceUsageReportOptions.SetBillingUnitID(v.(string))
+	}
+
+	pager, err := enterpriseUsageReportsClient.NewGetResourceUsageReportPager(getResourceUsageReportOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allRecs, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] GetResourceUsageReportWithContext failed %s", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(dataSourceIbmEnterpriseUsageReportsID(d))
+	err = d.Set("reports", dataSourceEnterpriseUsageReportsFlattenReports(allRecs))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting reports %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIbmEnterpriseUsageReportsID returns a reasonable ID for the list.
+func dataSourceIbmEnterpriseUsageReportsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceEnterpriseUsageReportsFlattenReports(result []enterpriseusagereportsv1.ResourceUsageReport) (reports []map[string]interface{}) {
+	reports = []map[string]interface{}{}
+	for _, reportsItem := range result {
+		reportsMap := map[string]interface{}{}
+		if reportsItem.EntityID != nil {
+			reportsMap["entity_id"] = reportsItem.EntityID
+		}
+		if reportsItem.EntityType != nil {
+			reportsMap["entity_type"] = reportsItem.EntityType
+		}
+		if reportsItem.EntityCRN != nil {
+			reportsMap["entity_crn"] = reportsItem.EntityCRN
+		}
+		if reportsItem.EntityName != nil {
+			reportsMap["entity_name"] = reportsItem.EntityName
+		}
+		if reportsItem.BillingUnitID != nil {
+			reportsMap["billing_unit_id"] = reportsItem.BillingUnitID
+		}
+		if reportsItem.BillingUnitCRN != nil {
+			reportsMap["billing_unit_crn"] = reportsItem.BillingUnitCRN
+		}
+		if reportsItem.BillingUnitName != nil {
+			reportsMap["billing_unit_name"] = reportsItem.BillingUnitName
+		}
+		if reportsItem.CountryCode != nil {
+			reportsMap["country_code"] = reportsItem.CountryCode
+		}
+		if reportsItem.CurrencyCode != nil {
+			reportsMap["currency_code"] = reportsItem.CurrencyCode
+		}
+		if reportsItem.Month != nil {
+			reportsMap["month"] = reportsItem.Month
+		}
+		if reportsItem.BillableCost != nil {
+			reportsMap["billable_cost"] = reportsItem.BillableCost
+		}
+		if reportsItem.NonBillableCost != nil {
+			reportsMap["non_billable_cost"] = reportsItem.NonBillableCost
+		}
+		if reportsItem.BillableRatedCost != nil {
+			reportsMap["billable_rated_cost"] = reportsItem.BillableRatedCost
+		}
+		if reportsItem.NonBillableRatedCost != nil {
+			reportsMap["non_billable_rated_cost"] = reportsItem.NonBillableRatedCost
+		}
+		reports = append(reports, reportsMap)
+	}
+
+	return reports
+}
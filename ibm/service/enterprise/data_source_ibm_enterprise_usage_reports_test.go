@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise" */
+func TestAccIbmEnterpriseUsageReportsDataSourceBasic(t *testing.T) {
+	accountGroupName := fmt.Sprintf("tf_gen_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmEnterpriseUsageReportsDataSourceConfigBasic(accountGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_usage_reports.usage_reports", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_usage_reports.usage_reports", "reports.#"),
+					resource.TestCheckResourceAttrPair("data.ibm_enterprise_usage_reports.usage_reports", "reports.0.entity_id",
+						"ibm_enterprise_account_group.enterprise_account_group", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmEnterpriseUsageReportsDataSourceConfigBasic(accountGroupName string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		resource "ibm_enterprise_account_group" "enterprise_account_group" {
+			parent = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name = "%s"
+			primary_contact_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+		}
+		data "ibm_enterprise_usage_reports" "usage_reports" {
+			account_group_id = ibm_enterprise_account_group.enterprise_account_group.id
+		}
+	`, accountGroupName)
+}
@@ -5,15 +5,22 @@ package eventnotification
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/pkcs12"
 
 	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
 )
@@ -25,6 +32,7 @@ func ResourceIBMEnAPNSDestination() *schema.Resource {
 		UpdateContext: resourceIBMEnAPNSDestinationUpdate,
 		DeleteContext: resourceIBMEnAPNSDestinationDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMEnAPNSDestinationCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"instance_guid": {
@@ -58,6 +66,11 @@ func ResourceIBMEnAPNSDestination() *schema.Resource {
 				Required:    true,
 				Description: "The Certificate File.",
 			},
+			"certificate_checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 checksum of the Certificate File, a new file content updates the destination in place.",
+			},
 			"config": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -273,7 +286,7 @@ func resourceIBMEnAPNSDestinationUpdate(context context.Context, d *schema.Resou
 	options.SetInstanceID(parts[0])
 	options.SetID(parts[1])
 
-	if ok := d.HasChanges("name", "description", "certificate", "config"); ok {
+	if ok := d.HasChanges("name", "description", "certificate_content_type", "certificate", "certificate_checksum", "config"); ok {
 		options.SetName(d.Get("name").(string))
 
 		if _, ok := d.GetOk("description"); ok {
@@ -344,6 +357,106 @@ func resourceIBMEnAPNSDestinationDelete(context context.Context, d *schema.Resou
 	return nil
 }
 
+// resourceIBMEnAPNSDestinationCustomizeDiff checks the certificate file against its content type and
+// the config params, so that a wrong key or password fails the plan instead of the notifications.
+// The checksum of the file makes a certificate rotated at the same path update the destination.
+func resourceIBMEnAPNSDestinationCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("certificate") || !diff.NewValueKnown("certificate_content_type") || !diff.NewValueKnown("config") {
+		return nil
+	}
+
+	path := diff.Get("certificate").(string)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		// The file is only needed to upload it, an unchanged destination is left as it is.
+		if diff.Id() != "" && !diff.HasChange("certificate") {
+			log.Printf("[WARN] Certificate file (%s) cannot be read, skipping its validation: %s", path, err)
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error opening Certificate file (%s): %s", path, err)
+	}
+
+	params := map[string]interface{}{}
+	if p, ok := diff.GetOk("config.0.params.0"); ok {
+		params = p.(map[string]interface{})
+	}
+	certificateType := diff.Get("certificate_content_type").(string)
+	if certType, ok := params["cert_type"].(string); ok && certType != certificateType {
+		return fmt.Errorf("[ERROR] config cert_type %q does not match certificate_content_type %q", certType, certificateType)
+	}
+
+	switch certificateType {
+	case "p8":
+		for _, param := range []string{"key_id", "team_id", "bundle_id"} {
+			if v, _ := params[param].(string); v == "" {
+				return fmt.Errorf("[ERROR] config %s is required for a p8 certificate", param)
+			}
+		}
+		if err = validateAPNSp8Key(content); err != nil {
+			return fmt.Errorf("[ERROR] Error validating Certificate file (%s): %s", path, err)
+		}
+	case "p12":
+		password, _ := params["password"].(string)
+		if err = validateAPNSp12Certificate(content, password); err != nil {
+			return fmt.Errorf("[ERROR] Error validating Certificate file (%s): %s", path, err)
+		}
+	default:
+		return fmt.Errorf("[ERROR] certificate_content_type must be p8 or p12, got %q", certificateType)
+	}
+
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	if diff.Get("certificate_checksum").(string) != checksum {
+		return diff.SetNew("certificate_checksum", checksum)
+	}
+	return nil
+}
+
+// validateAPNSp8Key checks that the content is an APNs authentication key, an EC private key in PKCS #8 PEM.
+func validateAPNSp8Key(content []byte) error {
+	block, _ := pem.Decode(content)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return fmt.Errorf("a p8 certificate must be a PEM encoded PRIVATE KEY")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("the p8 key cannot be parsed: %s", err)
+	}
+	if _, ok := key.(*ecdsa.PrivateKey); !ok {
+		return fmt.Errorf("a p8 key must be an EC private key, got %T", key)
+	}
+	return nil
+}
+
+// validateAPNSp12Certificate checks that the password opens the content, that it has a private key and
+// that its certificates have not expired.
+func validateAPNSp12Certificate(content []byte, password string) error {
+	blocks, err := pkcs12.ToPEM(content, password)
+	if err != nil {
+		return fmt.Errorf("the p12 certificate cannot be opened with the config password: %s", err)
+	}
+	hasKey, hasCertificate := false, false
+	for _, block := range blocks {
+		switch block.Type {
+		case "PRIVATE KEY":
+			hasKey = true
+		case "CERTIFICATE":
+			hasCertificate = true
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return fmt.Errorf("the p12 certificate cannot be parsed: %s", err)
+			}
+			if time.Now().After(certificate.NotAfter) {
+				return fmt.Errorf("the certificate %q expired on %s", certificate.Subject.CommonName, certificate.NotAfter.Format(time.RFC3339))
+			}
+		}
+	}
+	if !hasKey || !hasCertificate {
+		return fmt.Errorf("a p12 certificate must hold a certificate and its private key")
+	}
+	return nil
+}
+
 func APNSdestinationConfigMapToDestinationConfig(configParams map[string]interface{}, certificatetype string) en.DestinationConfig {
 	params := new(en.DestinationConfigOneOf)
 	if certificatetype == "p8" {
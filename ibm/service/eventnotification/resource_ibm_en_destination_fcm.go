@@ -67,6 +67,7 @@ func ResourceIBMEnFCMDestination() *schema.Resource {
 									"server_key": {
 										Type:        schema.TypeString,
 										Required:    true,
+										Sensitive:   true,
 										Description: "The Server_key value for FCM project.",
 									},
 									"pre_prod": {
@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMEventStreamsConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMEventStreamsConsumerGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the consumer group with this ID",
+			},
+			"consumer_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The consumer groups of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the consumer group",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the consumer group, for example Stable or Empty",
+						},
+						"members": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of active members of the consumer group",
+						},
+						"topics": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The topics that the consumer group has committed offsets for",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the topic",
+									},
+									"lag": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The total lag of the consumer group on the topic",
+									},
+									"partitions": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The offsets of the consumer group per partition",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"partition": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "The partition ID",
+												},
+												"committed_offset": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "The offset committed by the consumer group, -1 if none is committed",
+												},
+												"end_offset": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "The offset of the next message written to the partition",
+												},
+												"lag": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "The number of messages that the consumer group has not consumed yet, 0 if no offset is committed",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEventStreamsConsumerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	client, instanceCRN, err := createSaramaClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] dataSourceIBMEventStreamsConsumerGroupsRead createSaramaClient err %s", err)
+		return err
+	}
+	defer client.Close()
+	adminClient, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		log.Printf("[DEBUG] dataSourceIBMEventStreamsConsumerGroupsRead NewClusterAdminFromClient err %s", err)
+		return err
+	}
+
+	groupIDs := []string{}
+	if group, ok := d.GetOk("group"); ok {
+		groupIDs = append(groupIDs, group.(string))
+	} else {
+		groups, err := adminClient.ListConsumerGroups()
+		if err != nil {
+			log.Printf("[DEBUG] dataSourceIBMEventStreamsConsumerGroupsRead ListConsumerGroups err %s", err)
+			return err
+		}
+		for group := range groups {
+			groupIDs = append(groupIDs, group)
+		}
+		sort.Strings(groupIDs)
+	}
+
+	descriptions := map[string]*sarama.GroupDescription{}
+	if len(groupIDs) > 0 {
+		groupDescriptions, err := adminClient.DescribeConsumerGroups(groupIDs)
+		if err != nil {
+			log.Printf("[DEBUG] dataSourceIBMEventStreamsConsumerGroupsRead DescribeConsumerGroups err %s", err)
+			return err
+		}
+		for _, description := range groupDescriptions {
+			descriptions[description.GroupId] = description
+		}
+	}
+
+	consumerGroups := []map[string]interface{}{}
+	for _, group := range groupIDs {
+		consumerGroup := map[string]interface{}{
+			"group": group,
+		}
+		if description, ok := descriptions[group]; ok {
+			consumerGroup["state"] = description.State
+			consumerGroup["members"] = len(description.Members)
+		}
+		topics, err := consumerGroupTopicOffsets(client, adminClient, group)
+		if err != nil {
+			return err
+		}
+		consumerGroup["topics"] = topics
+		consumerGroups = append(consumerGroups, consumerGroup)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("resource_instance_id", instanceCRN)
+	if err = d.Set("consumer_groups", consumerGroups); err != nil {
+		return fmt.Errorf("[ERROR] Error setting consumer_groups: %s", err)
+	}
+	return nil
+}
+
+// createSaramaClient returns a Kafka client for the instance. The caller closes the client
+func createSaramaClient(d *schema.ResourceData, meta interface{}) (sarama.Client, string, error) {
+	config, brokerAddress, instanceCRN, err := createSaramaConfig(d, meta)
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := sarama.NewClient(brokerAddress, config)
+	if err != nil {
+		log.Printf("[DEBUG] createSaramaClient NewClient err %s", err)
+		return nil, "", err
+	}
+	return client, instanceCRN, nil
+}
+
+// consumerGroupTopicOffsets returns the committed offsets and the lag of the consumer group, per topic
+func consumerGroupTopicOffsets(client sarama.Client, adminClient sarama.ClusterAdmin, group string) ([]map[string]interface{}, error) {
+	offsets, err := adminClient.ListConsumerGroupOffsets(group, nil)
+	if err != nil {
+		log.Printf("[DEBUG] consumerGroupTopicOffsets ListConsumerGroupOffsets err %s", err)
+		return nil, err
+	}
+
+	topicNames := []string{}
+	for topic := range offsets.Blocks {
+		topicNames = append(topicNames, topic)
+	}
+	sort.Strings(topicNames)
+
+	topics := []map[string]interface{}{}
+	for _, topic := range topicNames {
+		partitionIDs := []int32{}
+		for partition := range offsets.Blocks[topic] {
+			partitionIDs = append(partitionIDs, partition)
+		}
+		sort.Slice(partitionIDs, func(i, j int) bool { return partitionIDs[i] < partitionIDs[j] })
+
+		var topicLag int64
+		partitions := []map[string]interface{}{}
+		for _, partition := range partitionIDs {
+			block := offsets.Blocks[topic][partition]
+			if block.Err != sarama.ErrNoError {
+				return nil, fmt.Errorf("[ERROR] Error getting the offset of consumer group %s on %s/%d: %s", group, topic, partition, block.Err)
+			}
+			endOffset, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				log.Printf("[DEBUG] consumerGroupTopicOffsets GetOffset err %s", err)
+				return nil, err
+			}
+			var lag int64
+			if block.Offset >= 0 {
+				lag = endOffset - block.Offset
+			}
+			topicLag += lag
+			partitions = append(partitions, map[string]interface{}{
+				"partition":        partition,
+				"committed_offset": block.Offset,
+				"end_offset":       endOffset,
+				"lag":              lag,
+			})
+		}
+		topics = append(topics, map[string]interface{}{
+			"name":       topic,
+			"lag":        topicLag,
+			"partitions": partitions,
+		})
+	}
+	return topics, nil
+}
@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	consumerGroupResetEarliest  = "earliest"
+	consumerGroupResetLatest    = "latest"
+	consumerGroupResetTimestamp = "timestamp"
+)
+
+func ResourceIBMEventStreamsConsumerGroupReset() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceIBMEventStreamsConsumerGroupResetCreate,
+		Read:          resourceIBMEventStreamsConsumerGroupResetRead,
+		Delete:        resourceIBMEventStreamsConsumerGroupResetDelete,
+		CustomizeDiff: resourceIBMEventStreamsConsumerGroupResetDiff,
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the consumer group. The consumer group must not have active members",
+			},
+			"topic": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The topic to reset the offsets of",
+			},
+			"reset_to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{consumerGroupResetEarliest, consumerGroupResetLatest, consumerGroupResetTimestamp}),
+				Description:  "Where to reset the offsets to: earliest, latest or timestamp",
+			},
+			"timestamp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The time to reset the offsets to, in RFC 3339 format, when reset_to is timestamp. Each partition is reset to the first message written at or after that time",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that reset the offsets again when they change.",
+			},
+			"partitions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The offsets that the consumer group was reset to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The partition ID",
+						},
+						"offset": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The committed offset",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsConsumerGroupResetDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// a timestamp computed from other resources is only known at apply time
+	if !diff.NewValueKnown("timestamp") {
+		return nil
+	}
+	if diff.Get("reset_to").(string) == consumerGroupResetTimestamp && diff.Get("timestamp").(string) == "" {
+		return fmt.Errorf("timestamp is required when reset_to is %s", consumerGroupResetTimestamp)
+	}
+	return nil
+}
+
+func resourceIBMEventStreamsConsumerGroupResetCreate(d *schema.ResourceData, meta interface{}) error {
+	group := d.Get("group").(string)
+	topic := d.Get("topic").(string)
+	resetTo := d.Get("reset_to").(string)
+
+	var resetTime int64
+	if resetTo == consumerGroupResetTimestamp {
+		t, err := time.Parse(time.RFC3339, d.Get("timestamp").(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Invalid timestamp %s: %s", d.Get("timestamp").(string), err)
+		}
+		resetTime = t.UnixNano() / int64(time.Millisecond)
+	}
+
+	client, instanceCRN, err := createSaramaClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsConsumerGroupResetCreate createSaramaClient err %s", err)
+		return err
+	}
+	defer client.Close()
+	adminClient, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsConsumerGroupResetCreate NewClusterAdminFromClient err %s", err)
+		return err
+	}
+
+	// Kafka only accepts offsets of a consumer group from outside the group when the group is inactive
+	descriptions, err := adminClient.DescribeConsumerGroups([]string{group})
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsConsumerGroupResetCreate DescribeConsumerGroups err %s", err)
+		return err
+	}
+	for _, description := range descriptions {
+		if description.GroupId == group && len(description.Members) > 0 {
+			return fmt.Errorf("[ERROR] Consumer group %s has %d active members, stop its consumers before resetting its offsets", group, len(description.Members))
+		}
+	}
+
+	partitionIDs, err := client.Partitions(topic)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsConsumerGroupResetCreate Partitions err %s", err)
+		return err
+	}
+	sort.Slice(partitionIDs, func(i, j int) bool { return partitionIDs[i] < partitionIDs[j] })
+
+	request := &sarama.OffsetCommitRequest{
+		Version:                 1,
+		ConsumerGroup:           group,
+		ConsumerGroupGeneration: sarama.GroupGenerationUndefined,
+	}
+	partitions := []map[string]interface{}{}
+	for _, partition := range partitionIDs {
+		offset, err := consumerGroupResetOffset(client, topic, partition, resetTo, resetTime)
+		if err != nil {
+			log.Printf("[DEBUG] resourceIBMEventStreamsConsumerGroupResetCreate GetOffset err %s", err)
+			return err
+		}
+		request.AddBlock(topic, partition, offset, sarama.ReceiveTime, "")
+		partitions = append(partitions, map[string]interface{}{
+			"partition": partition,
+			"offset":    offset,
+		})
+	}
+
+	coordinator, err := client.Coordinator(group)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsConsumerGroupResetCreate Coordinator err %s", err)
+		return err
+	}
+	response, err := coordinator.CommitOffset(request)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error resetting the offsets of consumer group %s on topic %s: %s", group, topic, err)
+	}
+	for partition, kerr := range response.Errors[topic] {
+		if kerr != sarama.ErrNoError {
+			return fmt.Errorf("[ERROR] Error resetting the offset of consumer group %s on %s/%d: %s", group, topic, partition, kerr)
+		}
+	}
+	log.Printf("[INFO] resourceIBMEventStreamsConsumerGroupResetCreate reset consumer group %s on topic %s to %s", group, topic, resetTo)
+
+	d.SetId(fmt.Sprintf("%s/%s", group, topic))
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("partitions", partitions)
+	return resourceIBMEventStreamsConsumerGroupResetRead(d, meta)
+}
+
+func resourceIBMEventStreamsConsumerGroupResetRead(d *schema.ResourceData, meta interface{}) error {
+	// the reset is a one-time action, the committed offsets move on as soon as the consumers resume
+	return nil
+}
+
+func resourceIBMEventStreamsConsumerGroupResetDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// consumerGroupResetOffset returns the offset of the partition to reset to
+func consumerGroupResetOffset(client sarama.Client, topic string, partition int32, resetTo string, resetTime int64) (int64, error) {
+	switch resetTo {
+	case consumerGroupResetEarliest:
+		return client.GetOffset(topic, partition, sarama.OffsetOldest)
+	case consumerGroupResetLatest:
+		return client.GetOffset(topic, partition, sarama.OffsetNewest)
+	}
+	offset, err := client.GetOffset(topic, partition, resetTime)
+	if err != nil {
+		return 0, err
+	}
+	if offset < 0 {
+		// no message was written at or after the time
+		return client.GetOffset(topic, partition, sarama.OffsetNewest)
+	}
+	return offset, nil
+}
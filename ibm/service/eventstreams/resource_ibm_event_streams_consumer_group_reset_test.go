@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsConsumerGroupResetBasic(t *testing.T) {
+	group := fmt.Sprintf("tf-group-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsConsumerGroupResetConfig(MZREnterpriseInstanceName, group, "latest", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_consumer_group_reset.reset", "id", fmt.Sprintf("%s/%s", group, topicName)),
+					resource.TestCheckResourceAttrSet("ibm_event_streams_consumer_group_reset.reset", "partitions.0.offset"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsConsumerGroupResetConfig(MZREnterpriseInstanceName, group, "earliest", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_consumer_group_reset.reset", "reset_to", "earliest"),
+					resource.TestCheckResourceAttrSet("ibm_event_streams_consumer_group_reset.reset", "partitions.0.offset"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsConsumerGroupResetConfig(instanceName, group, resetTo, run string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "my_group" {
+		is_default=true
+	  }
+	data "ibm_resource_instance" "es_instance" {
+		resource_group_id = data.ibm_resource_group.my_group.id
+		name              = "%s"
+	}
+	resource "ibm_event_streams_consumer_group_reset" "reset" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		group                = "%s"
+		topic                = "%s"
+		reset_to             = "%s"
+		triggers = {
+			run = "%s"
+		}
+	}`, instanceName, group, topicName, resetTo, run)
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	accessTagName = "name"
+	accessTagType = "access"
+)
+
+func ResourceIBMIamAccessTag() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMIamAccessTagCreate,
+		Read:     resourceIBMIamAccessTagRead,
+		Delete:   resourceIBMIamAccessTagDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			accessTagName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_iam_access_tag", accessTagName),
+				Description:  "Name of the access management tag, in the key:value format",
+			},
+			tagType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the tag, always access",
+			},
+		},
+	}
+}
+
+func ResourceIBMIamAccessTagValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 accessTagName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[A-Za-z0-9_ .-]+:[A-Za-z0-9_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+
+	ibmIamAccessTagValidator := validate.ResourceValidator{ResourceName: "ibm_iam_access_tag", Schema: validateSchema}
+	return &ibmIamAccessTagValidator
+}
+
+func resourceIBMIamAccessTagCreate(d *schema.ResourceData, meta interface{}) error {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	name := d.Get(accessTagName).(string)
+	createTagOptions := &globaltaggingv1.CreateTagOptions{
+		TagNames: []string{name},
+		TagType:  flex.PtrToString(accessTagType),
+	}
+	result, resp, err := gtClient.CreateTag(createTagOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating access tag %s: %s\n%s", name, err, resp)
+	}
+	for _, r := range result.Results {
+		if r.IsError != nil && *r.IsError {
+			return fmt.Errorf("[ERROR] Error creating access tag %s: %s", name, resp)
+		}
+	}
+
+	d.SetId(name)
+	return resourceIBMIamAccessTagRead(d, meta)
+}
+
+func resourceIBMIamAccessTagRead(d *schema.ResourceData, meta interface{}) error {
+	accessTags, err := listAccessTags(meta)
+	if err != nil {
+		return err
+	}
+	if !accessTags.Contains(d.Id()) {
+		log.Printf("[WARN] Access tag %s is not found, removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(accessTagName, d.Id())
+	d.Set(tagType, accessTagType)
+	return nil
+}
+
+func resourceIBMIamAccessTagDelete(d *schema.ResourceData, meta interface{}) error {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	deleteTagOptions := &globaltaggingv1.DeleteTagOptions{
+		TagName: flex.PtrToString(d.Id()),
+		TagType: flex.PtrToString(accessTagType),
+	}
+	result, resp, err := gtClient.DeleteTag(deleteTagOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting access tag %s: %s\n%s", d.Id(), err, resp)
+	}
+	for _, r := range result.Results {
+		if r.IsError != nil && *r.IsError {
+			return fmt.Errorf("[ERROR] Error deleting access tag %s, it may still be attached to resources: %s", d.Id(), resp)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// listAccessTags returns the names of all the access tags of the account
+func listAccessTags(meta interface{}) (*schema.Set, error) {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	var taglist []string
+	var offset, limit int64 = 0, 1000
+	for {
+		listTagsOptions := &globaltaggingv1.ListTagsOptions{
+			TagType: flex.PtrToString(accessTagType),
+			Offset:  &offset,
+			Limit:   &limit,
+		}
+		taggingResult, resp, err := gtClient.ListTags(listTagsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing access tags: %s\n%s", err, resp)
+		}
+		for _, item := range taggingResult.Items {
+			taglist = append(taglist, *item.Name)
+		}
+		offset += int64(len(taggingResult.Items))
+		if len(taggingResult.Items) == 0 || taggingResult.TotalCount == nil || offset >= *taggingResult.TotalCount {
+			break
+		}
+	}
+	return flex.NewStringSet(flex.ResourceIBMVPCHash, taglist), nil
+}
@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	accessTagAttachmentTagName   = "tag_name"
+	accessTagAttachmentResources = "resources"
+)
+
+func ResourceIBMIamAccessTagAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMIamAccessTagAttachmentCreate,
+		Read:     resourceIBMIamAccessTagAttachmentRead,
+		Update:   resourceIBMIamAccessTagAttachmentUpdate,
+		Delete:   resourceIBMIamAccessTagAttachmentDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			accessTagAttachmentTagName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_iam_access_tag_attachment", accessTagAttachmentTagName),
+				Description:  "Name of the access management tag to attach",
+			},
+			accessTagAttachmentResources: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.InvokeValidator("ibm_iam_access_tag_attachment", accessTagAttachmentResources),
+				},
+				Set:         schema.HashString,
+				Description: "CRNs of the resources the access tag is attached to. The tag is detached from resources that are removed from the list",
+			},
+		},
+	}
+}
+
+func ResourceIBMIamAccessTagAttachmentValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 accessTagAttachmentTagName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[A-Za-z0-9_ .-]+:[A-Za-z0-9_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 accessTagAttachmentResources,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^crn:v1(:[a-zA-Z0-9 \-\._~\*\+,;=!$&'\(\)\/\?#\[\]@]*){8}$`,
+			MinValueLength:             1,
+			MaxValueLength:             1024})
+
+	ibmIamAccessTagAttachmentValidator := validate.ResourceValidator{ResourceName: "ibm_iam_access_tag_attachment", Schema: validateSchema}
+	return &ibmIamAccessTagAttachmentValidator
+}
+
+func resourceIBMIamAccessTagAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get(accessTagAttachmentTagName).(string)
+	crns := flex.ExpandStringList(d.Get(accessTagAttachmentResources).(*schema.Set).List())
+
+	err := attachAccessTag(meta, name, crns, true)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return resourceIBMIamAccessTagAttachmentRead(d, meta)
+}
+
+func resourceIBMIamAccessTagAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	name := d.Id()
+
+	// keep only the resources that still have the tag, so that a detached tag is attached again
+	attached := []string{}
+	for _, crn := range d.Get(accessTagAttachmentResources).(*schema.Set).List() {
+		tagList, err := flex.GetGlobalTagsUsingSearchAPI(meta, crn.(string), "", accessTagType)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting access tags for: %s with error : %s", crn, err)
+		}
+		if tagList.Contains(name) {
+			attached = append(attached, crn.(string))
+		}
+	}
+
+	d.Set(accessTagAttachmentTagName, name)
+	d.Set(accessTagAttachmentResources, attached)
+	return nil
+}
+
+func resourceIBMIamAccessTagAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Id()
+
+	if d.HasChange(accessTagAttachmentResources) {
+		o, n := d.GetChange(accessTagAttachmentResources)
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		add := flex.ExpandStringList(ns.Difference(os).List())
+		remove := flex.ExpandStringList(os.Difference(ns).List())
+
+		if len(add) > 0 {
+			err := attachAccessTag(meta, name, add, true)
+			if err != nil {
+				return err
+			}
+		}
+		if len(remove) > 0 {
+			err := attachAccessTag(meta, name, remove, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceIBMIamAccessTagAttachmentRead(d, meta)
+}
+
+func resourceIBMIamAccessTagAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	crns := flex.ExpandStringList(d.Get(accessTagAttachmentResources).(*schema.Set).List())
+	if len(crns) > 0 {
+		err := attachAccessTag(meta, d.Id(), crns, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// attachAccessTag attaches the access tag to, or detaches it from, the resources
+func attachAccessTag(meta interface{}, name string, crns []string, attach bool) error {
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+
+	resources := make([]globaltaggingv1.Resource, 0, len(crns))
+	for _, crn := range crns {
+		resources = append(resources, globaltaggingv1.Resource{ResourceID: flex.PtrToString(crn)})
+	}
+
+	var result *globaltaggingv1.TagResults
+	if attach {
+		attachTagOptions := &globaltaggingv1.AttachTagOptions{
+			Resources: resources,
+			TagNames:  []string{name},
+			TagType:   flex.PtrToString(accessTagType),
+		}
+		result, _, err = gtClient.AttachTag(attachTagOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error attaching access tag %s: %s", name, err)
+		}
+	} else {
+		detachTagOptions := &globaltaggingv1.DetachTagOptions{
+			Resources: resources,
+			TagNames:  []string{name},
+			TagType:   flex.PtrToString(accessTagType),
+		}
+		result, _, err = gtClient.DetachTag(detachTagOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error detaching access tag %s: %s", name, err)
+		}
+	}
+
+	failed := []string{}
+	for _, r := range result.Results {
+		if r.IsError != nil && *r.IsError && r.ResourceID != nil {
+			failed = append(failed, *r.ResourceID)
+		}
+	}
+	if len(failed) > 0 {
+		action := "attaching"
+		if !attach {
+			action = "detaching"
+		}
+		return fmt.Errorf("[ERROR] Error %s access tag %s for resources: %s", action, name, strings.Join(failed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIamAccessTagAttachment_Basic(t *testing.T) {
+	tagName := fmt.Sprintf("env:tf-attach-%d", acctest.RandIntRange(10, 100))
+	vpcName := fmt.Sprintf("tf-access-tag-vpc-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIamAccessTagAttachmentCreate(tagName, vpcName, "ibm_is_vpc.vpc1.crn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_tag_attachment.attachment", "tag_name", tagName),
+					resource.TestCheckResourceAttr("ibm_iam_access_tag_attachment.attachment", "resources.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIamAccessTagAttachmentCreate(tagName, vpcName, "ibm_is_vpc.vpc1.crn, ibm_is_vpc.vpc2.crn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_tag_attachment.attachment", "resources.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIamAccessTagAttachmentCreate(tagName, vpcName, crns string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_access_tag" "tag" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_is_vpc" "vpc1" {
+		name = "%[2]s-1"
+	}
+
+	resource "ibm_is_vpc" "vpc2" {
+		name = "%[2]s-2"
+	}
+
+	resource "ibm_iam_access_tag_attachment" "attachment" {
+		tag_name  = ibm_iam_access_tag.tag.name
+		resources = [%[3]s]
+	}
+`, tagName, vpcName, crns)
+}
@@ -424,8 +424,8 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 	if err != nil || instance == nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error when creating HPCS instance: %s with resp code: %s", err, resp))
 	}
-	d.SetId(*instance.ID)                       // Set Resource ID
-	_, err = waitForHPCSInstanceCreate(d, meta) // Wait for Instance to be available
+	d.SetId(*instance.ID)                                // Set Resource ID
+	_, err = waitForHPCSInstanceCreate(context, d, meta) // Wait for Instance to be available
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"[ERROR] Error waiting for create HPCS instance (%s) to be succeeded: %s", d.Id(), err))
@@ -649,7 +649,7 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating HPCS instance: %s with resp code: %s", err, resp))
 		}
 
-		_, err = waitForHPCSInstanceUpdate(d, meta)
+		_, err = waitForHPCSInstanceUpdate(context, d, meta)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(
 				"[ERROR] Error waiting for update HPCS instance (%s) to be succeeded: %s", d.Id(), err))
@@ -759,7 +759,7 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 	if error != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting HPCS instance: %s with resp code: %s", error, resp))
 	}
-	_, err = waitForHPCSInstanceDelete(d, meta)
+	_, err = waitForHPCSInstanceDelete(context, d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"[ERROR] Error waiting for HPCS instance (%s) to be deleted: %s", d.Id(), err))
@@ -769,7 +769,7 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 
 	return nil
 }
-func waitForHPCSInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForHPCSInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -800,10 +800,10 @@ func waitForHPCSInstanceCreate(d *schema.ResourceData, meta interface{}) (interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForHPCSInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForHPCSInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -834,10 +834,10 @@ func waitForHPCSInstanceUpdate(d *schema.ResourceData, meta interface{}) (interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func waitForHPCSInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForHPCSInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -867,7 +867,7 @@ func waitForHPCSInstanceDelete(d *schema.ResourceData, meta interface{}) (interf
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 func resourceIBMHPCSAdminHash(v interface{}) int {
 	var buf bytes.Buffer
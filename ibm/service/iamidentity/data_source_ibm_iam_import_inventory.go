@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func DataSourceIBMIamImportInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIamImportInventoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The account to list the resources of. Defaults to the account of the provider.",
+			},
+			"resources": flex.ImportInventorySchema(),
+		},
+	}
+}
+
+func dataSourceIBMIamImportInventoryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	inventory := &flex.ImportInventory{}
+	var pagesize int64 = 100
+
+	start := ""
+	for {
+		listServiceIdsOptions := &iamidentityv1.ListServiceIdsOptions{
+			AccountID: &accountID,
+			Pagesize:  &pagesize,
+		}
+		if start != "" {
+			listServiceIdsOptions.Pagetoken = &start
+		}
+		serviceIDs, response, err := iamIdentityClient.ListServiceIdsWithContext(context, listServiceIdsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListServiceIdsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing service IDs: %s\n%s", err, response))
+		}
+		for _, serviceID := range serviceIDs.Serviceids {
+			inventory.Add("ibm_iam_service_id", *serviceID.Name, *serviceID.ID)
+		}
+		start = flex.GetNextIAM(serviceIDs.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	for {
+		listProfilesOptions := &iamidentityv1.ListProfilesOptions{
+			AccountID: &accountID,
+			Pagesize:  &pagesize,
+		}
+		if start != "" {
+			listProfilesOptions.Pagetoken = &start
+		}
+		profiles, response, err := iamIdentityClient.ListProfilesWithContext(context, listProfilesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListProfilesWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing trusted profiles: %s\n%s", err, response))
+		}
+		for _, profile := range profiles.Profiles {
+			inventory.Add("ibm_iam_trusted_profile", *profile.Name, *profile.ID)
+		}
+		start = flex.GetNextIAM(profiles.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	// The Public Access group cannot be managed, so it is left out.
+	offset := int64(0)
+	listAccessGroupsOptions := iamAccessGroupsClient.NewListAccessGroupsOptions(accountID)
+	listAccessGroupsOptions.SetLimit(pagesize)
+	listAccessGroupsOptions.SetHidePublicAccess(true)
+	for {
+		listAccessGroupsOptions.SetOffset(offset)
+		groups, response, err := iamAccessGroupsClient.ListAccessGroupsWithContext(context, listAccessGroupsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccessGroupsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing access groups: %s\n%s", err, response))
+		}
+		for _, group := range groups.Groups {
+			inventory.Add("ibm_iam_access_group", *group.Name, *group.ID)
+		}
+		offset += int64(len(groups.Groups))
+		if len(groups.Groups) == 0 || groups.TotalCount == nil || offset >= *groups.TotalCount {
+			break
+		}
+	}
+
+	d.SetId(accountID)
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("resources", inventory.Resources()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources: %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIamImportInventoryDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamImportInventoryDataSourceConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_import_inventory.iam_import_inventory", "account_id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.ibm_iam_import_inventory.iam_import_inventory", "resources.*", map[string]string{
+						"type":    "ibm_iam_service_id",
+						"name":    name,
+						"address": "ibm_iam_service_id." + name,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIamImportInventoryDataSourceConfigBasic(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+
+		data "ibm_iam_import_inventory" "iam_import_inventory" {
+			depends_on = [ibm_iam_service_id.serviceID]
+		}
+	`, name)
+}
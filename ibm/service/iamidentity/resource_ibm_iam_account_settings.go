@@ -4,17 +4,22 @@
 package iamidentity
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 )
 
 const (
@@ -22,6 +27,13 @@ const (
 	restrictCreateServiceId = "restrict_create_service_id"
 	restrictCreateApiKey    = "restrict_create_platform_apikey"
 	mfa                     = "mfa"
+
+	restrictCreateServiceIdExceptions = "restrict_create_service_id_exception_access_groups"
+	restrictCreateApiKeyExceptions    = "restrict_create_platform_apikey_exception_access_groups"
+
+	// Roles on the IAM Identity service that exempt a subject from the create restrictions
+	serviceIdCreatorRole  = "crn:v1:bluemix:public:iam-identity::::serviceRole:ServiceIdCreator"
+	userApiKeyCreatorRole = "crn:v1:bluemix:public:iam-identity::::serviceRole:UserApiKeyCreator"
 )
 
 func ResourceIBMIAMAccountSettings() *schema.Resource {
@@ -32,6 +44,8 @@ func ResourceIBMIAMAccountSettings() *schema.Resource {
 		DeleteContext: resourceIbmIamAccountSettingsDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIbmIamAccountSettingsAllowedIPAddressesDiff,
+
 		Schema: map[string]*schema.Schema{
 			"include_history": {
 				Type:        schema.TypeBool,
@@ -53,11 +67,37 @@ func ResourceIBMIAMAccountSettings() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator(accountSettings, "restrict_create_platform_apikey"),
 				Description:  "Defines whether or not creating platform API keys is access controlled. Valid values:  * RESTRICTED - to apply access control  * NOT_RESTRICTED - to remove access control  * NOT_SET - to 'unset' a previous set value.",
 			},
+			restrictCreateServiceIdExceptions: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "IDs of the access groups that are allowed to create service IDs when restrict_create_service_id is RESTRICTED. Each access group is granted the Service ID creator role on the IAM Identity service.",
+			},
+			restrictCreateApiKeyExceptions: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "IDs of the access groups that are allowed to create platform API keys when restrict_create_platform_apikey is RESTRICTED. Each access group is granted the User API key creator role on the IAM Identity service.",
+			},
 			"allowed_ip_addresses": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Defines the IP addresses and subnets from which IAM tokens can be created for the account.",
 			},
+			"caller_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateIP,
+				Description:  "The public IP address that Terraform runs from. The plan fails if allowed_ip_addresses does not include it, so that the account settings do not lock out the caller.",
+			},
+			"skip_caller_ip_address_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Apply allowed_ip_addresses even if it does not include caller_ip_address.",
+			},
 			"entity_tag": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -202,6 +242,62 @@ func ResourceIBMIAMAccountSettingsValidator() *validate.ResourceValidator {
 	return &ibmIAMAccountSettingsValidator
 }
 
+func resourceIbmIamAccountSettingsAllowedIPAddressesDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	callerIP := diff.Get("caller_ip_address").(string)
+	allowedIPs := diff.Get("allowed_ip_addresses").(string)
+	if callerIP == "" || strings.TrimSpace(allowedIPs) == "" || diff.Get("skip_caller_ip_address_check").(bool) {
+		return nil
+	}
+	allowed, err := ipAddressAllowed(callerIP, allowedIPs)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return fmt.Errorf("[ERROR] allowed_ip_addresses does not include the caller IP address %s, applying it would block IAM token creation from this address. Add the address or set skip_caller_ip_address_check", callerIP)
+	}
+	return nil
+}
+
+// ipAddressAllowed reports whether the IP address is in the comma separated list of
+// IP addresses, IP ranges (first-last) and subnets of the allowed IP addresses setting
+func ipAddressAllowed(address, allowedIPAddresses string) (bool, error) {
+	ip := net.ParseIP(address)
+	for _, entry := range strings.Split(allowedIPAddresses, ",") {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == "":
+			continue
+		case strings.Contains(entry, "/"):
+			_, subnet, err := net.ParseCIDR(entry)
+			if err != nil {
+				return false, fmt.Errorf("[ERROR] Invalid subnet %q in allowed_ip_addresses: %s", entry, err)
+			}
+			if subnet.Contains(ip) {
+				return true, nil
+			}
+		case strings.Contains(entry, "-"):
+			bounds := strings.SplitN(entry, "-", 2)
+			first := net.ParseIP(strings.TrimSpace(bounds[0]))
+			last := net.ParseIP(strings.TrimSpace(bounds[1]))
+			if first == nil || last == nil {
+				return false, fmt.Errorf("[ERROR] Invalid IP range %q in allowed_ip_addresses", entry)
+			}
+			if bytes.Compare(ip.To16(), first.To16()) >= 0 && bytes.Compare(ip.To16(), last.To16()) <= 0 {
+				return true, nil
+			}
+		default:
+			allowedIP := net.ParseIP(entry)
+			if allowedIP == nil {
+				return false, fmt.Errorf("[ERROR] Invalid IP address %q in allowed_ip_addresses", entry)
+			}
+			if allowedIP.Equal(ip) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 func resourceIbmIamAccountSettingsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -305,6 +401,29 @@ func resourceIbmIamAccountSettingsRead(context context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("Error setting system_refresh_token_expiration_in_seconds: %s", err))
 	}
 
+	for attribute, roleID := range map[string]string{
+		restrictCreateServiceIdExceptions: serviceIdCreatorRole,
+		restrictCreateApiKeyExceptions:    userApiKeyCreatorRole,
+	} {
+		accessGroups := d.Get(attribute).(*schema.Set).List()
+		if len(accessGroups) == 0 {
+			continue
+		}
+		exceptions := make([]string, 0, len(accessGroups))
+		for _, accessGroupID := range flex.ExpandStringList(accessGroups) {
+			policyIDs, err := resourceIbmIamAccountSettingsExceptionPolicies(meta, d.Id(), attribute, accessGroupID, roleID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if len(policyIDs) > 0 {
+				exceptions = append(exceptions, accessGroupID)
+			}
+		}
+		if err = d.Set(attribute, exceptions); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s: %s", attribute, err))
+		}
+	}
+
 	return nil
 }
 
@@ -401,9 +520,155 @@ func resourceIbmIamAccountSettingsUpdate(context context.Context, d *schema.Reso
 		}
 	}
 
+	if d.HasChange(restrictCreateServiceIdExceptions) {
+		err = resourceIbmIamAccountSettingsUpdateExceptions(d, meta, restrictCreateServiceIdExceptions, serviceIdCreatorRole)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange(restrictCreateApiKeyExceptions) {
+		err = resourceIbmIamAccountSettingsUpdateExceptions(d, meta, restrictCreateApiKeyExceptions, userApiKeyCreatorRole)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIbmIamAccountSettingsRead(context, d, meta)
 }
 
+// resourceIbmIamAccountSettingsUpdateExceptions grants roleID on the IAM Identity service to the access groups
+// added to attribute and revokes it from the removed ones.
+func resourceIbmIamAccountSettingsUpdateExceptions(d *schema.ResourceData, meta interface{}, attribute, roleID string) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	accountID := d.Id()
+
+	o, n := d.GetChange(attribute)
+	oldSet := o.(*schema.Set)
+	newSet := n.(*schema.Set)
+	remove := flex.ExpandStringList(oldSet.Difference(newSet).List())
+	add := flex.ExpandStringList(newSet.Difference(oldSet).List())
+
+	for _, accessGroupID := range remove {
+		err = resourceIbmIamAccountSettingsRemoveException(meta, accountID, attribute, accessGroupID, roleID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, accessGroupID := range add {
+		policyIDs, err := resourceIbmIamAccountSettingsExceptionPolicies(meta, accountID, attribute, accessGroupID, roleID)
+		if err != nil {
+			return err
+		}
+		if len(policyIDs) > 0 {
+			continue
+		}
+		subject := iampolicymanagementv1.PolicySubject{
+			Attributes: []iampolicymanagementv1.SubjectAttribute{
+				{
+					Name:  core.StringPtr("access_group_id"),
+					Value: core.StringPtr(accessGroupID),
+				},
+			},
+		}
+		policyResource := iampolicymanagementv1.PolicyResource{
+			Attributes: []iampolicymanagementv1.ResourceAttribute{
+				{
+					Name:     core.StringPtr("accountId"),
+					Value:    core.StringPtr(accountID),
+					Operator: core.StringPtr("stringEquals"),
+				},
+				{
+					Name:     core.StringPtr("serviceName"),
+					Value:    core.StringPtr("iam-identity"),
+					Operator: core.StringPtr("stringEquals"),
+				},
+			},
+		}
+		createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
+			"access",
+			[]iampolicymanagementv1.PolicySubject{subject},
+			[]iampolicymanagementv1.PolicyRole{{RoleID: core.StringPtr(roleID)}},
+			[]iampolicymanagementv1.PolicyResource{policyResource},
+		)
+		createPolicyOptions.SetDescription(resourceIbmIamAccountSettingsExceptionDescription(attribute))
+		_, response, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error adding %s exception for access group %s: %s\n%s", attribute, accessGroupID, err, response)
+		}
+	}
+
+	return nil
+}
+
+// resourceIbmIamAccountSettingsRemoveException deletes the policies created for attribute that grant roleID to the
+// access group.
+func resourceIbmIamAccountSettingsRemoveException(meta interface{}, accountID, attribute, accessGroupID, roleID string) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	policyIDs, err := resourceIbmIamAccountSettingsExceptionPolicies(meta, accountID, attribute, accessGroupID, roleID)
+	if err != nil {
+		return err
+	}
+	for _, policyID := range policyIDs {
+		deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(policyID)
+		response, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error removing %s exception for access group %s: %s\n%s", attribute, accessGroupID, err, response)
+		}
+	}
+	return nil
+}
+
+// resourceIbmIamAccountSettingsExceptionDescription is the description of the policies created for attribute. It is
+// used to tell them apart from policies granting the same role that are managed elsewhere.
+func resourceIbmIamAccountSettingsExceptionDescription(attribute string) string {
+	return fmt.Sprintf("Managed by the ibm_iam_account_settings %s argument", attribute)
+}
+
+// resourceIbmIamAccountSettingsExceptionPolicies returns the IDs of the access group policies created for attribute
+// that grant exactly roleID on the IAM Identity service of the account.
+func resourceIbmIamAccountSettingsExceptionPolicies(meta interface{}, accountID, attribute, accessGroupID, roleID string) ([]string, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID:     core.StringPtr(accountID),
+		AccessGroupID: core.StringPtr(accessGroupID),
+		Type:          core.StringPtr("access"),
+	}
+	policyList, response, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing policies of access group %s: %s\n%s", accessGroupID, err, response)
+	}
+
+	description := resourceIbmIamAccountSettingsExceptionDescription(attribute)
+	policyIDs := []string{}
+	for _, policy := range policyList.Policies {
+		if policy.ID == nil || policy.Description == nil || *policy.Description != description {
+			continue
+		}
+		if len(policy.Roles) != 1 || policy.Roles[0].RoleID == nil || *policy.Roles[0].RoleID != roleID {
+			continue
+		}
+		for _, policyResource := range policy.Resources {
+			for _, attribute := range policyResource.Attributes {
+				if attribute.Name != nil && *attribute.Name == "serviceName" && attribute.Value != nil && *attribute.Value == "iam-identity" {
+					policyIDs = append(policyIDs, *policy.ID)
+				}
+			}
+		}
+	}
+	return policyIDs, nil
+}
+
 func resourceIBMIamAccountSettingsMapToAccountSettingsUserMfa(userMfaMap map[string]interface{}) iamidentityv1.AccountSettingsUserMfa {
 	userMfa := iamidentityv1.AccountSettingsUserMfa{}
 	userMfa.IamID = core.StringPtr(userMfaMap["iam_id"].(string))
@@ -420,7 +685,18 @@ func resourceIBMIamAccountSettingsAccountSettingsUserMfaToMap(model *iamidentity
 
 func resourceIbmIamAccountSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	// DELETE NOT SUPPORTED
+	// DELETE NOT SUPPORTED for the account settings, but the exception policies are revoked
+	for attribute, roleID := range map[string]string{
+		restrictCreateServiceIdExceptions: serviceIdCreatorRole,
+		restrictCreateApiKeyExceptions:    userApiKeyCreatorRole,
+	} {
+		for _, accessGroupID := range flex.ExpandStringList(d.Get(attribute).(*schema.Set).List()) {
+			err := resourceIbmIamAccountSettingsRemoveException(meta, d.Id(), attribute, accessGroupID, roleID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
 	d.SetId("")
 
 	return nil
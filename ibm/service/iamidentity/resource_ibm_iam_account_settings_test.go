@@ -5,11 +5,13 @@ package iamidentity_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -105,6 +107,58 @@ func TestAccIBMIAMAccountSettingsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccountSettingsRestrictExceptions(t *testing.T) {
+	var conf iamidentityv1.AccountSettingsResponse
+	agName := fmt.Sprintf("tf-account-settings-ag-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIamAccountSettingsRestrictExceptionsConfig(agName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIamAccountSettingsExists("ibm_iam_account_settings.iam_account_settings", conf),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.iam_account_settings", "restrict_create_service_id_exception_access_groups.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.iam_account_settings", "restrict_create_platform_apikey_exception_access_groups.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIbmIamAccountSettingsRestrictExceptionsConfig(agName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.iam_account_settings", "restrict_create_service_id_exception_access_groups.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.iam_account_settings", "restrict_create_platform_apikey_exception_access_groups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMAccountSettingsCallerIPAddressCheck(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIbmIamAccountSettingsCallerIPAddressConfig("192.0.2.10", "198.51.100.0/24, 203.0.113.5", false),
+				ExpectError: regexp.MustCompile("does not include the caller IP address 192.0.2.10"),
+			},
+			{
+				Config: testAccCheckIbmIamAccountSettingsCallerIPAddressConfig("192.0.2.10", "198.51.100.0/24, 192.0.2.1-192.0.2.20", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.iam_account_settings", "allowed_ip_addresses", "198.51.100.0/24, 192.0.2.1-192.0.2.20"),
+				),
+			},
+			{
+				Config: testAccCheckIbmIamAccountSettingsCallerIPAddressConfig("192.0.2.10", "", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.iam_account_settings", "allowed_ip_addresses", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmIamAccountSettingsConfigBasic() string {
 	return `
 
@@ -153,6 +207,24 @@ func testAccCheckIbmIamAccountSettingsUpdateConfig() string {
 	)
 }
 
+func testAccCheckIbmIamAccountSettingsRestrictExceptionsConfig(agName string, apiKeyException bool) string {
+	apiKeyExceptions := "[]"
+	if apiKeyException {
+		apiKeyExceptions = "[ibm_iam_access_group.access_group.id]"
+	}
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_access_group" "access_group" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_account_settings" "iam_account_settings" {
+			restrict_create_service_id_exception_access_groups = [ibm_iam_access_group.access_group.id]
+			restrict_create_platform_apikey_exception_access_groups = %s
+		}
+	`, agName, apiKeyExceptions)
+}
+
 func testAccCheckIbmIamAccountSettingsUpdateConfigWithNoUserMfa() string {
 	return fmt.Sprintf(`
 
@@ -245,3 +317,13 @@ func testAccCheckIbmIamAccountSettingsExists(n string, obj iamidentityv1.Account
 		return nil
 	}
 }
+
+func testAccCheckIbmIamAccountSettingsCallerIPAddressConfig(callerIP, allowedIPs string, skipCheck bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_account_settings" "iam_account_settings" {
+			caller_ip_address            = "%s"
+			allowed_ip_addresses         = "%s"
+			skip_caller_ip_address_check = %t
+		}
+	`, callerIP, allowedIPs, skipCheck)
+}
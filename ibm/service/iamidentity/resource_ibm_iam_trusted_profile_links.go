@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func ResourceIBMIAMTrustedProfileLinks() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIamTrustedProfileLinksCreate,
+		ReadContext:   resourceIBMIamTrustedProfileLinksRead,
+		UpdateContext: resourceIBMIamTrustedProfileLinksUpdate,
+		DeleteContext: resourceIBMIamTrustedProfileLinksDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the trusted profile.",
+				ValidateFunc: validate.InvokeValidator("ibm_iam_trusted_profile_links",
+					"profile_id"),
+			},
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, links of the trusted profile that are not listed in link are removed.",
+			},
+			"link": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The compute resources to link to the trusted profile.",
+				Set:         resourceIBMIamTrustedProfileLinksHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cr_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"VSI", "IKS_SA", "ROKS_SA"}),
+							Description:  "The compute resource type. Valid values are VSI, IKS_SA, ROKS_SA.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the compute resource.",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The compute resource namespace, only required if cr_type is IKS_SA or ROKS_SA.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the compute resource, only required if cr_type is IKS_SA or ROKS_SA.",
+						},
+						"link_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Optional name of the Link.",
+						},
+						"link_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique identifier of this link.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMIAMTrustedProfileLinksValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "profile_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "iam",
+			CloudDataRange:             []string{"service:trusted_profile", "resolved_to:id"},
+			Required:                   true})
+
+	iBMIAMTrustedProfileLinksValidator := validate.ResourceValidator{ResourceName: "ibm_iam_trusted_profile_links", Schema: validateSchema}
+	return &iBMIAMTrustedProfileLinksValidator
+}
+
+func resourceIBMIamTrustedProfileLinksCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile := d.Get("profile_id").(string)
+	d.SetId(profile)
+
+	existing, err := resourceIBMIamTrustedProfileLinksList(context, iamIdentityClient, profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, l := range d.Get("link").(*schema.Set).List() {
+		linkMap := l.(map[string]interface{})
+		if _, ok := existing[resourceIBMIamTrustedProfileLinksKey(linkMap)]; ok {
+			continue
+		}
+		if err = resourceIBMIamTrustedProfileLinksCreateLink(context, iamIdentityClient, profile, linkMap); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.Get("exclusive").(bool) {
+		if err = resourceIBMIamTrustedProfileLinksRemoveUnlisted(context, iamIdentityClient, profile, existing, d.Get("link").(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIamTrustedProfileLinksRead(context, d, meta)
+}
+
+func resourceIBMIamTrustedProfileLinksRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile := d.Id()
+	existing, err := resourceIBMIamTrustedProfileLinksList(context, iamIdentityClient, profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if existing == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Only report the links this resource manages, unless it owns every link of the profile
+	// or it is being imported.
+	managed := map[string]bool{}
+	for _, l := range d.Get("link").(*schema.Set).List() {
+		managed[resourceIBMIamTrustedProfileLinksKey(l.(map[string]interface{}))] = true
+	}
+	reportAll := d.Get("exclusive").(bool) || len(managed) == 0
+
+	links := []map[string]interface{}{}
+	for key, profileLink := range existing {
+		if !reportAll && !managed[key] {
+			continue
+		}
+		links = append(links, resourceIBMIamTrustedProfileLinksToMap(profileLink))
+	}
+
+	if err = d.Set("profile_id", profile); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting profile_id: %s", err))
+	}
+	if err = d.Set("link", links); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting link: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMIamTrustedProfileLinksUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile := d.Id()
+	existing, err := resourceIBMIamTrustedProfileLinksList(context, iamIdentityClient, profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("link") {
+		o, n := d.GetChange("link")
+		oldLinks, newLinks := o.(*schema.Set), n.(*schema.Set)
+
+		// A link cannot be updated, so changed links are removed and created again.
+		for _, l := range oldLinks.Difference(newLinks).List() {
+			if profileLink, ok := existing[resourceIBMIamTrustedProfileLinksKey(l.(map[string]interface{}))]; ok {
+				if err = resourceIBMIamTrustedProfileLinksDeleteLink(context, iamIdentityClient, profile, *profileLink.ID); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+		for _, l := range newLinks.Difference(oldLinks).List() {
+			linkMap := l.(map[string]interface{})
+			if profileLink, ok := existing[resourceIBMIamTrustedProfileLinksKey(linkMap)]; ok && resourceIBMIamTrustedProfileLinksNameOf(profileLink) == linkMap["link_name"].(string) {
+				continue
+			}
+			if err = resourceIBMIamTrustedProfileLinksCreateLink(context, iamIdentityClient, profile, linkMap); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if d.Get("exclusive").(bool) {
+		if err = resourceIBMIamTrustedProfileLinksRemoveUnlisted(context, iamIdentityClient, profile, existing, d.Get("link").(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIamTrustedProfileLinksRead(context, d, meta)
+}
+
+func resourceIBMIamTrustedProfileLinksDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile := d.Id()
+	existing, err := resourceIBMIamTrustedProfileLinksList(context, iamIdentityClient, profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, l := range d.Get("link").(*schema.Set).List() {
+		if profileLink, ok := existing[resourceIBMIamTrustedProfileLinksKey(l.(map[string]interface{}))]; ok {
+			if err = resourceIBMIamTrustedProfileLinksDeleteLink(context, iamIdentityClient, profile, *profileLink.ID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIBMIamTrustedProfileLinksList returns the links of the trusted profile keyed by the compute resource they link,
+// or nil if the trusted profile does not exist
+func resourceIBMIamTrustedProfileLinksList(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, profile string) (map[string]iamidentityv1.ProfileLink, error) {
+	listLinksOptions := &iamidentityv1.ListLinksOptions{}
+	listLinksOptions.SetProfileID(profile)
+
+	profileLinkList, response, err := iamIdentityClient.ListLinksWithContext(context, listLinksOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil, nil
+		}
+		log.Printf("[DEBUG] ListLinksWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("ListLinksWithContext failed %s\n%s", err, response)
+	}
+
+	links := make(map[string]iamidentityv1.ProfileLink, len(profileLinkList.Links))
+	for _, profileLink := range profileLinkList.Links {
+		links[resourceIBMIamTrustedProfileLinksKey(resourceIBMIamTrustedProfileLinksToMap(profileLink))] = profileLink
+	}
+	return links, nil
+}
+
+func resourceIBMIamTrustedProfileLinksCreateLink(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, profile string, linkMap map[string]interface{}) error {
+	createLinkOptions := &iamidentityv1.CreateLinkOptions{}
+	createLinkOptions.SetProfileID(profile)
+	createLinkOptions.SetCrType(linkMap["cr_type"].(string))
+	link := resourceIBMIamTrustedProfileLinkMapToCreateProfileLinkRequestLink(linkMap)
+	createLinkOptions.SetLink(&link)
+	if linkName := linkMap["link_name"].(string); linkName != "" {
+		createLinkOptions.SetName(linkName)
+	}
+
+	_, response, err := iamIdentityClient.CreateLinkWithContext(context, createLinkOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateLink failed %s\n%s", err, response)
+		return fmt.Errorf("CreateLink failed for %s %s: %s\n%s", linkMap["cr_type"], linkMap["crn"], err, response)
+	}
+	return nil
+}
+
+func resourceIBMIamTrustedProfileLinksDeleteLink(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, profile string, linkID string) error {
+	deleteLinkOptions := &iamidentityv1.DeleteLinkOptions{}
+	deleteLinkOptions.SetProfileID(profile)
+	deleteLinkOptions.SetLinkID(linkID)
+
+	response, err := iamIdentityClient.DeleteLinkWithContext(context, deleteLinkOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteLink failed %s\n%s", err, response)
+		return fmt.Errorf("DeleteLink failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func resourceIBMIamTrustedProfileLinksRemoveUnlisted(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, profile string, existing map[string]iamidentityv1.ProfileLink, links *schema.Set) error {
+	listed := map[string]bool{}
+	for _, l := range links.List() {
+		listed[resourceIBMIamTrustedProfileLinksKey(l.(map[string]interface{}))] = true
+	}
+	for key, profileLink := range existing {
+		if listed[key] {
+			continue
+		}
+		if err := resourceIBMIamTrustedProfileLinksDeleteLink(context, iamIdentityClient, profile, *profileLink.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceIBMIamTrustedProfileLinksToMap(profileLink iamidentityv1.ProfileLink) map[string]interface{} {
+	linkMap := map[string]interface{}{
+		"cr_type":   core.StringNilMapper(profileLink.CrType),
+		"link_name": resourceIBMIamTrustedProfileLinksNameOf(profileLink),
+		"link_id":   core.StringNilMapper(profileLink.ID),
+	}
+	if profileLink.Link != nil {
+		linkMap["crn"] = core.StringNilMapper(profileLink.Link.CRN)
+		linkMap["namespace"] = core.StringNilMapper(profileLink.Link.Namespace)
+		linkMap["name"] = core.StringNilMapper(profileLink.Link.Name)
+	}
+	return linkMap
+}
+
+func resourceIBMIamTrustedProfileLinksNameOf(profileLink iamidentityv1.ProfileLink) string {
+	return core.StringNilMapper(profileLink.Name)
+}
+
+// resourceIBMIamTrustedProfileLinksKey identifies a link by the compute resource it links to
+func resourceIBMIamTrustedProfileLinksKey(linkMap map[string]interface{}) string {
+	key := make([]string, 0, 4)
+	for _, k := range []string{"cr_type", "crn", "namespace", "name"} {
+		v, _ := linkMap[k].(string)
+		key = append(key, v)
+	}
+	return strings.Join(key, "|")
+}
+
+func resourceIBMIamTrustedProfileLinksHash(v interface{}) int {
+	linkMap := v.(map[string]interface{})
+	linkName, _ := linkMap["link_name"].(string)
+	return conns.String(fmt.Sprintf("%s|%s", resourceIBMIamTrustedProfileLinksKey(linkMap), linkName))
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func TestAccIBMIAMTrustedProfileLinksBasic(t *testing.T) {
+	profileName := fmt.Sprintf("tf_profile_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIamTrustedProfileLinksDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamTrustedProfileLinksConfig(profileName, []string{"namespace-a", "namespace-b"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_links.iam_trusted_profile_links", "link.#", "2"),
+					testAccCheckIBMIamTrustedProfileLinksCount("ibm_iam_trusted_profile_links.iam_trusted_profile_links", 2),
+				),
+			},
+			{
+				Config: testAccCheckIBMIamTrustedProfileLinksConfig(profileName, []string{"namespace-b", "namespace-c", "namespace-d"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_links.iam_trusted_profile_links", "link.#", "3"),
+					testAccCheckIBMIamTrustedProfileLinksCount("ibm_iam_trusted_profile_links.iam_trusted_profile_links", 3),
+				),
+			},
+			{
+				ResourceName:            "ibm_iam_trusted_profile_links.iam_trusted_profile_links",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"exclusive"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIamTrustedProfileLinksConfig(profileName string, namespaces []string) string {
+	links := ""
+	for _, namespace := range namespaces {
+		links += fmt.Sprintf(`
+			link {
+				cr_type = "IKS_SA"
+				crn = "%s"
+				namespace = "%s"
+				name = "default"
+			}`, acc.IksSa, namespace)
+	}
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "iam_trusted_profile" {
+			name = "%s"
+		}
+		resource "ibm_iam_trusted_profile_links" "iam_trusted_profile_links" {
+			profile_id = ibm_iam_trusted_profile.iam_trusted_profile.id
+			%s
+		}
+	`, profileName, links)
+}
+
+func testAccCheckIBMIamTrustedProfileLinksCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		iamIdentityClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+
+		listLinksOptions := &iamidentityv1.ListLinksOptions{}
+		listLinksOptions.SetProfileID(rs.Primary.ID)
+
+		profileLinkList, _, err := iamIdentityClient.ListLinks(listLinksOptions)
+		if err != nil {
+			return err
+		}
+		if len(profileLinkList.Links) != count {
+			return fmt.Errorf("expected %d links on trusted profile %s, found %d", count, rs.Primary.ID, len(profileLinkList.Links))
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIamTrustedProfileLinksDestroy(s *terraform.State) error {
+	iamIdentityClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile_links" {
+			continue
+		}
+
+		listLinksOptions := &iamidentityv1.ListLinksOptions{}
+		listLinksOptions.SetProfileID(rs.Primary.ID)
+
+		profileLinkList, response, err := iamIdentityClient.ListLinks(listLinksOptions)
+		if err == nil && len(profileLinkList.Links) > 0 {
+			return fmt.Errorf("iam_trusted_profile_links still exist: %s", rs.Primary.ID)
+		} else if err != nil && response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for iam_trusted_profile_links (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
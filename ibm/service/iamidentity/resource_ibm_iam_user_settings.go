@@ -16,6 +16,7 @@ import (
 const (
 	iamUserSettingIamID              = "iam_id"
 	iamUserSettingAllowedIPAddresses = "allowed_ip_addresses"
+	iamUserSettingSelfManage         = "self_manage"
 )
 
 func ResourceIBMIAMUserSettings() *schema.Resource {
@@ -43,6 +44,13 @@ func ResourceIBMIAMUserSettings() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of allowed IPv4 or IPv6 addresses ",
 			},
+
+			iamUserSettingSelfManage: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether user-managed login is enabled for the user",
+			},
 		},
 	}
 }
@@ -67,8 +75,14 @@ func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	UserSettingsPayload := v2.UserSettingOptions{}
+	// Start from the current settings so that the ones not managed here, such as
+	// the console language, are sent back unchanged.
+	UserSettingsPayload, UserSettingError := client.GetUserSettings(accountID, iamID)
+	if UserSettingError != nil {
+		return fmt.Errorf("[ERROR] Error getting user settings: %s", UserSettingError)
+	}
 
+	UserSettingsPayload.AllowedIPAddresses = ""
 	if ip, ok := d.GetOk(iamUserSettingAllowedIPAddresses); ok && ip != nil {
 		var ips = make([]string, 0)
 		for _, i := range ip.([]interface{}) {
@@ -78,7 +92,11 @@ func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{})
 		UserSettingsPayload.AllowedIPAddresses = ipStr
 	}
 
-	_, UserSettingError := client.ManageUserSettings(accountID, iamID, UserSettingsPayload)
+	if selfManage, ok := d.GetOkExists(iamUserSettingSelfManage); ok {
+		UserSettingsPayload.SelfManage = selfManage.(bool)
+	}
+
+	_, UserSettingError = client.ManageUserSettings(accountID, iamID, UserSettingsPayload)
 
 	if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
 		return fmt.Errorf("[ERROR] Error occured during user settings: %s", UserSettingError)
@@ -119,8 +137,13 @@ func resourceIBMIAMUserSettingsRead(d *schema.ResourceData, meta interface{}) er
 		return UserSettingError
 	}
 
-	iplist := strings.Split(UserSettings.AllowedIPAddresses, ",")
+	iplist := make([]string, 0)
+	if UserSettings.AllowedIPAddresses != "" {
+		iplist = strings.Split(UserSettings.AllowedIPAddresses, ",")
+	}
+	d.Set(iamUserSettingIamID, d.Id())
 	d.Set(iamUserSettingAllowedIPAddresses, iplist)
+	d.Set(iamUserSettingSelfManage, UserSettings.SelfManage)
 
 	return nil
 
@@ -146,9 +169,13 @@ func resourceIBMIAMUserSettingsUpdate(d *schema.ResourceData, meta interface{})
 
 	hasChanged := false
 
-	userSettingPayload := v2.UserSettingOptions{}
+	userSettingPayload, UserSettingError := client.GetUserSettings(accountID, iamID)
+	if UserSettingError != nil {
+		return fmt.Errorf("[ERROR] Error getting user settings: %s", UserSettingError)
+	}
 
 	if d.HasChange(iamUserSettingAllowedIPAddresses) {
+		userSettingPayload.AllowedIPAddresses = ""
 		if ip, ok := d.GetOk(iamUserSettingAllowedIPAddresses); ok && ip != nil {
 			var ips = make([]string, 0)
 			for _, i := range ip.([]interface{}) {
@@ -160,6 +187,11 @@ func resourceIBMIAMUserSettingsUpdate(d *schema.ResourceData, meta interface{})
 		hasChanged = true
 	}
 
+	if d.HasChange(iamUserSettingSelfManage) {
+		userSettingPayload.SelfManage = d.Get(iamUserSettingSelfManage).(bool)
+		hasChanged = true
+	}
+
 	if hasChanged {
 		_, UserSettingError := client.ManageUserSettings(accountID, iamID, userSettingPayload)
 		if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
@@ -42,6 +42,38 @@ func TestAccIBMIAMUserSettings_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMUserSettings_SelfManage(t *testing.T) {
+	t.Skip()
+	var allowedIP string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMUserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMUserSettingsSelfManage(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMUserSettingsExists("ibm_iam_user_settings.user_settings", allowedIP),
+					resource.TestCheckResourceAttr("ibm_iam_user_settings.user_settings", "allowed_ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_user_settings.user_settings", "self_manage", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMUserSettingsSelfManage(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_user_settings.user_settings", "self_manage", "false"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_user_settings.user_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMUserSettingsDestroy(s *terraform.State) error {
 
 	userManagement, err := acc.TestAccProvider.Meta().(conns.ClientSession).UserManagementAPI()
@@ -144,3 +176,13 @@ func testAccCheckIBMIAMUserSettingsUpdate() string {
 
 	`, acc.IAMUser)
 }
+
+func testAccCheckIBMIAMUserSettingsSelfManage(selfManage bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_user_settings" "user_settings" {
+		iam_id = "%s"
+		allowed_ip_addresses = ["192.168.0.0","192.168.0.1"]
+		self_manage = %t
+	  }
+	`, acc.IAMUser, selfManage)
+}
@@ -0,0 +1,394 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Data source to list the policies of an account, normalized so that the output is stable between reads
+func DataSourceIBMIAMAccountPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMAccountPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The unique ID of an account",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": {
+				Description:  "Only list the policies of this type, `access` or `authorization`",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"access", "authorization"}, false),
+			},
+			"iam_id": {
+				Description:   "Only list the policies of this subject, the IAM ID of a user, service ID or trusted profile",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"access_group_id"},
+			},
+			"access_group_id": {
+				Description:   "Only list the policies of this access group",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"iam_id"},
+			},
+			"service_name": {
+				Description: "Only list the policies on resources of this service",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role": {
+				Description: "Only list the policies that grant this role, by display name or role CRN",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"transaction_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Set transactionID for debug",
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The policies found, sorted by ID",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The policy ID",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The policy type, `access` or `authorization`",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the Policy",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the policy",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the policy was created",
+						},
+						"last_modified_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the policy was last modified",
+						},
+						"subject_iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the subject, empty when the subject is not a user, service ID or trusted profile",
+						},
+						"subject_access_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The access group of the subject, empty when the subject is not an access group",
+						},
+						"subject_attributes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "All the attributes of the subject, sorted by name",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The roles granted by the policy, sorted by role CRN",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The role CRN",
+									},
+									"display_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The display name of the role",
+									},
+								},
+							},
+						},
+						"service_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service of the resources, empty when the policy is not scoped to a service",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource group of the resources, empty when the policy is not scoped to a resource group",
+						},
+						"resource_instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service instance of the resources, empty when the policy is not scoped to an instance",
+						},
+						"resource_attributes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "All the attributes of the resources, sorted by name",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"resource_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The access tags of the resources, sorted by name",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMAccountPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	var accountID string
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	if account, ok := d.GetOk("account_id"); ok && account.(string) != "" {
+		accountID = account.(string)
+	} else {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID: core.StringPtr(accountID),
+	}
+	if v, ok := d.GetOk("type"); ok {
+		listPoliciesOptions.Type = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("iam_id"); ok {
+		listPoliciesOptions.IamID = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("access_group_id"); ok {
+		listPoliciesOptions.AccessGroupID = core.StringPtr(v.(string))
+	}
+
+	if transactionID, ok := d.GetOk("transaction_id"); ok {
+		listPoliciesOptions.SetHeaders(map[string]string{"Transaction-Id": transactionID.(string)})
+	}
+
+	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+
+	if err != nil || resp == nil {
+		return fmt.Errorf("[ERROR] Error listing policies: %s, %s", err, resp)
+	}
+
+	// The service and role filters are not supported by the API, so they are applied here.
+	serviceName := d.Get("service_name").(string)
+	role := d.Get("role").(string)
+
+	policies := make([]map[string]interface{}, 0, len(policyList.Policies))
+	for _, policy := range policyList.Policies {
+		p := flattenAccountPolicy(policy)
+		if serviceName != "" && p["service_name"] != serviceName {
+			continue
+		}
+		if role != "" && !accountPolicyHasRole(policy, role) {
+			continue
+		}
+		policies = append(policies, p)
+	}
+	sort.SliceStable(policies, func(i, j int) bool {
+		return policies[i]["id"].(string) < policies[j]["id"].(string)
+	})
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("account_id", accountID)
+
+	if len(resp.Headers["Transaction-Id"]) > 0 && resp.Headers["Transaction-Id"][0] != "" {
+		d.Set("transaction_id", resp.Headers["Transaction-Id"][0])
+	}
+
+	if err = d.Set("policies", policies); err != nil {
+		return fmt.Errorf("[ERROR] Error setting policies: %s", err)
+	}
+
+	return nil
+}
+
+func accountPolicyHasRole(policy iampolicymanagementv1.Policy, role string) bool {
+	for _, r := range policy.Roles {
+		if (r.RoleID != nil && *r.RoleID == role) || (r.DisplayName != nil && strings.EqualFold(*r.DisplayName, role)) {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenAccountPolicy(policy iampolicymanagementv1.Policy) map[string]interface{} {
+	p := map[string]interface{}{
+		"id":          "",
+		"type":        "",
+		"description": "",
+		"state":       "",
+	}
+	if policy.ID != nil {
+		p["id"] = *policy.ID
+	}
+	if policy.Type != nil {
+		p["type"] = *policy.Type
+	}
+	if policy.Description != nil {
+		p["description"] = *policy.Description
+	}
+	if policy.State != nil {
+		p["state"] = *policy.State
+	}
+	if policy.CreatedAt != nil {
+		p["created_at"] = policy.CreatedAt.String()
+	}
+	if policy.LastModifiedAt != nil {
+		p["last_modified_at"] = policy.LastModifiedAt.String()
+	}
+
+	subjectAttributes := []map[string]interface{}{}
+	if len(policy.Subjects) > 0 {
+		subject := policy.Subjects[0]
+		p["subject_iam_id"] = *flex.GetSubjectAttribute("iam_id", subject)
+		p["subject_access_group_id"] = *flex.GetSubjectAttribute("access_group_id", subject)
+		for _, attribute := range subject.Attributes {
+			subjectAttributes = append(subjectAttributes, map[string]interface{}{
+				"name":  *attribute.Name,
+				"value": *attribute.Value,
+			})
+		}
+	}
+	sort.SliceStable(subjectAttributes, func(i, j int) bool {
+		return subjectAttributes[i]["name"].(string) < subjectAttributes[j]["name"].(string)
+	})
+	p["subject_attributes"] = subjectAttributes
+
+	roles := []map[string]interface{}{}
+	for _, role := range policy.Roles {
+		r := map[string]interface{}{
+			"role_id": *role.RoleID,
+		}
+		if role.DisplayName != nil {
+			r["display_name"] = *role.DisplayName
+		}
+		roles = append(roles, r)
+	}
+	sort.SliceStable(roles, func(i, j int) bool {
+		return roles[i]["role_id"].(string) < roles[j]["role_id"].(string)
+	})
+	p["roles"] = roles
+
+	// An attribute or tag without operator is matched by equality, it is reported as such.
+	resourceAttributes := []map[string]interface{}{}
+	resourceTags := []map[string]interface{}{}
+	if len(policy.Resources) > 0 {
+		resource := policy.Resources[0]
+		p["service_name"] = *flex.GetResourceAttribute("serviceName", resource)
+		p["resource_group_id"] = *flex.GetResourceAttribute("resourceGroupId", resource)
+		p["resource_instance_id"] = *flex.GetResourceAttribute("serviceInstance", resource)
+		for _, attribute := range resource.Attributes {
+			a := map[string]interface{}{
+				"name":     *attribute.Name,
+				"value":    *attribute.Value,
+				"operator": "stringEquals",
+			}
+			if attribute.Operator != nil {
+				a["operator"] = *attribute.Operator
+			}
+			resourceAttributes = append(resourceAttributes, a)
+		}
+		for _, tag := range resource.Tags {
+			t := map[string]interface{}{
+				"name":     *tag.Name,
+				"value":    *tag.Value,
+				"operator": "stringEquals",
+			}
+			if tag.Operator != nil {
+				t["operator"] = *tag.Operator
+			}
+			resourceTags = append(resourceTags, t)
+		}
+	}
+	sort.SliceStable(resourceAttributes, func(i, j int) bool {
+		return resourceAttributes[i]["name"].(string) < resourceAttributes[j]["name"].(string)
+	})
+	sort.SliceStable(resourceTags, func(i, j int) bool {
+		return resourceTags[i]["name"].(string) < resourceTags[j]["name"].(string)
+	})
+	p["resource_attributes"] = resourceAttributes
+	p["resource_tags"] = resourceTags
+
+	return p
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMAccountPoliciesDataSource_Filters(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccountPoliciesDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_account_policies.by_subject", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_account_policies.by_subject", "policies.0.service_name", "kms"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_account_policies.by_subject", "policies.0.subject_iam_id", "ibm_iam_service_id.serviceID", "iam_id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_account_policies.by_subject", "policies.0.roles.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_account_policies.by_role", "policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccountPoliciesDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_policy" "policy" {
+		iam_service_id = ibm_iam_service_id.serviceID.id
+		roles          = ["Viewer"]
+
+		resources {
+			service = "kms"
+		}
+	}
+
+	data "ibm_iam_account_policies" "by_subject" {
+		iam_id       = ibm_iam_service_id.serviceID.iam_id
+		service_name = "kms"
+		depends_on   = [ibm_iam_service_policy.policy]
+	}
+
+	data "ibm_iam_account_policies" "by_role" {
+		iam_id     = ibm_iam_service_id.serviceID.iam_id
+		role       = "Administrator"
+		depends_on = [ibm_iam_service_policy.policy]
+	}
+	`, name)
+}
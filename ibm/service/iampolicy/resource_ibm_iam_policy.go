@@ -0,0 +1,366 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMIAMPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMIAMPolicyCreate,
+		Read:     resourceIBMIAMPolicyRead,
+		Update:   resourceIBMIAMPolicyUpdate,
+		Delete:   resourceIBMIAMPolicyDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"policy_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIAMPolicyJSON,
+				StateFunc: func(v interface{}) string {
+					normalized, err := normalizeIAMPolicyJSON(v.(string))
+					if err != nil {
+						return v.(string)
+					}
+					return normalized
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					normalizedOld, err := normalizeIAMPolicyJSON(old)
+					if err != nil {
+						return false
+					}
+					normalizedNew, err := normalizeIAMPolicyJSON(new)
+					if err != nil {
+						return false
+					}
+					return normalizedOld == normalizedNew
+				},
+				Description: "The v2 policy document in JSON, with the type, description, subject, control, resource, pattern and rule of the policy",
+			},
+
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy type, access or authorization",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy state",
+			},
+
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href link back to the policy",
+			},
+		},
+	}
+}
+
+// iamPolicyDocument holds the writable fields of a v2 policy, in the order they are
+// written to the state.
+type iamPolicyDocument struct {
+	Type        *string                                     `json:"type"`
+	Description *string                                     `json:"description,omitempty"`
+	Subject     *iampolicymanagementv1.V2PolicyBaseSubject  `json:"subject,omitempty"`
+	Control     *iampolicymanagementv1.V2PolicyBaseControl  `json:"control"`
+	Resource    *iampolicymanagementv1.V2PolicyBaseResource `json:"resource,omitempty"`
+	Pattern     *string                                     `json:"pattern,omitempty"`
+	Rule        iampolicymanagementv1.V2PolicyBaseRuleIntf  `json:"rule,omitempty"`
+}
+
+func parseIAMPolicyJSON(policyJSON string) (*iamPolicyDocument, error) {
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(policyJSON), &m); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %s", err)
+	}
+	for key := range m {
+		switch key {
+		case "type", "description", "subject", "control", "resource", "pattern", "rule":
+		default:
+			return nil, fmt.Errorf("unsupported policy field %q", key)
+		}
+	}
+
+	doc := &iamPolicyDocument{}
+	if err := core.UnmarshalPrimitive(m, "type", &doc.Type); err != nil {
+		return nil, err
+	}
+	if err := core.UnmarshalPrimitive(m, "description", &doc.Description); err != nil {
+		return nil, err
+	}
+	if err := core.UnmarshalModel(m, "subject", &doc.Subject, iampolicymanagementv1.UnmarshalV2PolicyBaseSubject); err != nil {
+		return nil, err
+	}
+	if err := core.UnmarshalModel(m, "control", &doc.Control, iampolicymanagementv1.UnmarshalV2PolicyBaseControl); err != nil {
+		return nil, err
+	}
+	if err := core.UnmarshalModel(m, "resource", &doc.Resource, iampolicymanagementv1.UnmarshalV2PolicyBaseResource); err != nil {
+		return nil, err
+	}
+	if err := core.UnmarshalPrimitive(m, "pattern", &doc.Pattern); err != nil {
+		return nil, err
+	}
+	var rule *iampolicymanagementv1.V2PolicyBaseRule
+	if err := core.UnmarshalModel(m, "rule", &rule, iampolicymanagementv1.UnmarshalV2PolicyBaseRule); err != nil {
+		return nil, err
+	}
+
+	if doc.Type == nil || (*doc.Type != "access" && *doc.Type != "authorization") {
+		return nil, fmt.Errorf("type must be access or authorization")
+	}
+	if doc.Control == nil || doc.Control.Grant == nil || len(doc.Control.Grant.Roles) == 0 {
+		return nil, fmt.Errorf("control.grant.roles must contain at least one role")
+	}
+	roles := make([]iampolicymanagementv1.PolicyRole, 0, len(doc.Control.Grant.Roles))
+	for _, role := range doc.Control.Grant.Roles {
+		if role.RoleID == nil || *role.RoleID == "" {
+			return nil, fmt.Errorf("every role in control.grant.roles must have a role_id")
+		}
+		// Only the role ID is written, the display name and description are set by the API.
+		roles = append(roles, iampolicymanagementv1.PolicyRole{RoleID: role.RoleID})
+	}
+	doc.Control.Grant.Roles = roles
+	if doc.Subject != nil {
+		if err := validateIAMPolicyAttributes("subject", doc.Subject.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	if doc.Resource != nil {
+		if err := validateIAMPolicyAttributes("resource", doc.Resource.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	if rule != nil {
+		if len(rule.Conditions) > 0 {
+			if rule.Key != nil || rule.Value != nil || rule.Operator == nil {
+				return nil, fmt.Errorf("a rule with conditions must only have an operator and conditions")
+			}
+			if err := validateIAMPolicyAttributes("rule", rule.Conditions); err != nil {
+				return nil, err
+			}
+		} else if rule.Key == nil || rule.Operator == nil || rule.Value == nil {
+			return nil, fmt.Errorf("a rule must have either key, operator and value, or operator and conditions")
+		}
+		doc.Rule = rule
+	}
+	return doc, nil
+}
+
+func validateIAMPolicyAttributes(field string, attributes []iampolicymanagementv1.V2PolicyAttribute) error {
+	for i := range attributes {
+		if err := core.ValidateStruct(&attributes[i], fmt.Sprintf("%s attribute", field)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func normalizeIAMPolicyJSON(policyJSON string) (string, error) {
+	doc, err := parseIAMPolicyJSON(policyJSON)
+	if err != nil {
+		return "", err
+	}
+	normalized, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+func validateIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseIAMPolicyJSON(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid v2 policy document: %s", k, err))
+	}
+	return
+}
+
+func resourceIBMIAMPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	doc, err := parseIAMPolicyJSON(d.Get("policy_json").(string))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing policy_json: %s", err)
+	}
+
+	createPolicyOptions := &iampolicymanagementv1.V2CreatePolicyOptions{
+		Type:        doc.Type,
+		Description: doc.Description,
+		Subject:     doc.Subject,
+		Control:     doc.Control,
+		Resource:    doc.Resource,
+		Pattern:     doc.Pattern,
+		Rule:        doc.Rule,
+	}
+
+	policy, res, err := iamPolicyManagementClient.V2CreatePolicy(createPolicyOptions)
+	if err != nil || policy == nil {
+		return fmt.Errorf("[ERROR] Error creating policy: %s\n%s", err, res)
+	}
+	d.SetId(*policy.ID)
+
+	return resourceIBMIAMPolicyRead(d, meta)
+}
+
+func resourceIBMIAMPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	policy := &iampolicymanagementv1.V2Policy{}
+	res := &core.DetailedResponse{}
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		policy, res, err = getIAMV2Policy(iamPolicyManagementClient, d.Id())
+		if err != nil || policy == nil {
+			if res != nil && res.StatusCode == 404 && d.IsNewResource() {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		policy, res, err = getIAMV2Policy(iamPolicyManagementClient, d.Id())
+	}
+	if err != nil || policy == nil {
+		if res != nil && res.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving policy: %s\n%s", err, res)
+	}
+	if policy.State != nil && *policy.State == "deleted" {
+		d.SetId("")
+		return nil
+	}
+
+	doc := &iamPolicyDocument{
+		Type:        policy.Type,
+		Description: policy.Description,
+		Subject:     policy.Subject,
+		Control:     policy.Control,
+		Resource:    policy.Resource,
+		Pattern:     policy.Pattern,
+		Rule:        policy.Rule,
+	}
+	policyJSON, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error encoding policy: %s", err)
+	}
+	normalized, err := normalizeIAMPolicyJSON(string(policyJSON))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error normalizing policy: %s", err)
+	}
+
+	d.Set("policy_json", normalized)
+	d.Set("type", policy.Type)
+	d.Set("state", policy.State)
+	d.Set("href", policy.Href)
+	return nil
+}
+
+// getIAMV2Policy gets a policy from the v2 API. V2GetPolicy of the SDK decodes the response
+// as a v1 policy, which drops the v2 fields, so the request is sent directly.
+func getIAMV2Policy(iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, policyID string) (result *iampolicymanagementv1.V2Policy, response *core.DetailedResponse, err error) {
+	pathParamsMap := map[string]string{
+		"policy_id": policyID,
+	}
+	builder := core.NewRequestBuilder(core.GET)
+	builder.EnableGzipCompression = iamPolicyManagementClient.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(iamPolicyManagementClient.Service.Options.URL, `/v2/policies/{policy_id}`, pathParamsMap)
+	if err != nil {
+		return
+	}
+	builder.AddHeader("Accept", "application/json")
+	request, err := builder.Build()
+	if err != nil {
+		return
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err = iamPolicyManagementClient.Service.Request(request, &rawResponse)
+	if err != nil {
+		return
+	}
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, iampolicymanagementv1.UnmarshalV2Policy)
+		if err != nil {
+			return
+		}
+		response.Result = result
+	}
+	return
+}
+
+func resourceIBMIAMPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("policy_json") {
+		doc, err := parseIAMPolicyJSON(d.Get("policy_json").(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing policy_json: %s", err)
+		}
+
+		_, res, err := getIAMV2Policy(iamPolicyManagementClient, d.Id())
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving policy: %s\n%s", err, res)
+		}
+		policyETag := res.Headers.Get("ETag")
+
+		updatePolicyOptions := &iampolicymanagementv1.V2UpdatePolicyOptions{
+			PolicyID:    core.StringPtr(d.Id()),
+			IfMatch:     core.StringPtr(policyETag),
+			Type:        doc.Type,
+			Description: doc.Description,
+			Subject:     doc.Subject,
+			Control:     doc.Control,
+			Resource:    doc.Resource,
+			Pattern:     doc.Pattern,
+			Rule:        doc.Rule,
+		}
+		_, res, err = iamPolicyManagementClient.V2UpdatePolicy(updatePolicyOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating policy: %s\n%s", err, res)
+		}
+	}
+
+	return resourceIBMIAMPolicyRead(d, meta)
+}
+
+func resourceIBMIAMPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	deletePolicyOptions := &iampolicymanagementv1.V2DeletePolicyOptions{
+		PolicyID: core.StringPtr(d.Id()),
+	}
+	_, err = iamPolicyManagementClient.V2DeletePolicy(deletePolicyOptions)
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMPolicy_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyBasic(name, "Viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy.policy", "type", "access"),
+					resource.TestCheckResourceAttr("ibm_iam_policy.policy", "state", "active"),
+					resource.TestCheckResourceAttrSet("ibm_iam_policy.policy", "href"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyBasic(name, "Editor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("ibm_iam_policy.policy", "policy_json", regexp.MustCompile(`role:Editor`)),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMPolicy_InvalidDocument(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "ibm_iam_policy" "policy" {
+						policy_json = jsonencode({
+							type    = "access"
+							control = { grant = { roles = [] } }
+						})
+					}
+				`,
+				ExpectError: regexp.MustCompile(`control.grant.roles must contain at least one role`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyBasic(name, role string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_iam_account_settings" "settings" {
+		}
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_policy" "policy" {
+			policy_json = jsonencode({
+				type        = "access"
+				description = "IAM Policy from a JSON document"
+				subject = {
+					attributes = [{ key = "iam_id", operator = "stringEquals", value = ibm_iam_service_id.serviceID.iam_id }]
+				}
+				control = {
+					grant = { roles = [{ role_id = "crn:v1:bluemix:public:iam::::role:%s" }] }
+				}
+				resource = {
+					attributes = [
+						{ key = "accountId", operator = "stringEquals", value = data.ibm_iam_account_settings.settings.account_id },
+						{ key = "serviceName", operator = "stringEquals", value = "kms" },
+					]
+				}
+				pattern = "time-based-conditions:weekly:all-day"
+				rule = {
+					operator = "and"
+					conditions = [
+						{ key = "{{environment.attributes.day_of_week}}", operator = "dayOfWeekAnyOf", value = ["1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"] },
+					]
+				}
+			})
+		}
+
+	`, name, role)
+}
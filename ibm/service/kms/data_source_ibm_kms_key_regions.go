@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMKMSKeyRegions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMKMSKeyRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Key protect or hpcs instance GUIDs or CRNs, at most one per region",
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The alias of the equivalent key in each instance",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_crns": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRN of the key in each region, keyed by region",
+			},
+			"key_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ID of the key in each region, keyed by region",
+			},
+		},
+	}
+}
+
+func dataSourceIBMKMSKeyRegionsRead(d *schema.ResourceData, meta interface{}) error {
+	aliasName := d.Get("alias").(string)
+	keyCRNs := make(map[string]interface{})
+	keyIDs := make(map[string]interface{})
+	instanceIDs := make([]string, 0)
+
+	for _, v := range d.Get("instance_ids").([]interface{}) {
+		instanceID := getInstanceIDFromCRN(v.(string))
+		api, instanceCRN, err := populateKPClient(d, meta, instanceID)
+		if err != nil {
+			return err
+		}
+		region := ""
+		if instanceCRN != nil {
+			crnSegments := strings.Split(*instanceCRN, ":")
+			if len(crnSegments) > 5 {
+				region = crnSegments[5]
+			}
+		}
+		if region == "" {
+			return fmt.Errorf("[ERROR] Unable to determine the region of instance %s", instanceID)
+		}
+		if _, ok := keyCRNs[region]; ok {
+			return fmt.Errorf("[ERROR] More than one instance in region %s, instance %s is a duplicate", region, instanceID)
+		}
+
+		key, err := api.GetKey(context.Background(), aliasName)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Get Key with alias %s in instance %s failed with error: %s", aliasName, instanceID, err)
+		}
+		keyCRNs[region] = key.CRN
+		keyIDs[region] = key.ID
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", aliasName, strings.Join(instanceIDs, ",")))
+	d.Set("key_crns", keyCRNs)
+	d.Set("key_ids", keyIDs)
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKeyRegionsDataSource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	aliasName := fmt.Sprintf("alias_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyRegionsDataSourceConfig(instanceName, keyName, aliasName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_kms_key_regions.test", "key_crns.%", "2"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_regions.test", "key_crns.us-south", "ibm_kms_key.us_south", "crn"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_regions.test", "key_crns.us-east", "ibm_kms_key.us_east", "crn"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_regions.test", "key_ids.us-east", "ibm_kms_key.us_east", "key_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyRegionsDataSourceConfig(instanceName, keyName, aliasName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "us_south" {
+		name     = "%[1]s-us-south"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_resource_instance" "us_east" {
+		name     = "%[1]s-us-east"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-east"
+	}
+	resource "ibm_kms_key" "us_south" {
+		instance_id  = ibm_resource_instance.us_south.guid
+		key_name     = "%[2]s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_key" "us_east" {
+		instance_id  = ibm_resource_instance.us_east.guid
+		key_name     = "%[2]s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_key_alias" "us_south" {
+		instance_id = ibm_kms_key.us_south.instance_id
+		alias       = "%[3]s"
+		key_id      = ibm_kms_key.us_south.key_id
+	}
+	resource "ibm_kms_key_alias" "us_east" {
+		instance_id = ibm_kms_key.us_east.instance_id
+		alias       = "%[3]s"
+		key_id      = ibm_kms_key.us_east.key_id
+	}
+	data "ibm_kms_key_regions" "test" {
+		instance_ids = [ibm_kms_key_alias.us_south.instance_id, ibm_kms_key_alias.us_east.instance_id]
+		alias        = ibm_kms_key_alias.us_east.alias
+	}
+`, instanceName, keyName, aliasName)
+}
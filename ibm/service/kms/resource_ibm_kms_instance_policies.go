@@ -226,7 +226,10 @@ func resourceIBMKmsInstancePolicyCreate(context context.Context, d *schema.Resou
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	policyCreateOrUpdate(context, d, kpAPI)
+	err = policyCreateOrUpdate(context, d, kpAPI)
+	if err != nil {
+		return diag.Errorf("Could not create the policies: %s", err)
+	}
 	d.SetId(*instanceCRN)
 	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
 }
@@ -252,7 +255,7 @@ func resourceIBMKmsInstancePoliciesRead(context context.Context, d *schema.Resou
 
 func resourceIBMKmsInstancePolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	if d.HasChange("rotation") || d.HasChange("dual_auth_delete") || d.HasChange("metric") || d.HasChange("key_create_import_access") {
+	if d.HasChange("rotation") || d.HasChange("dual_auth_delete") || d.HasChange("metrics") || d.HasChange("key_create_import_access") {
 
 		instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
 		kpAPI, _, err := populateKPClient(d, meta, instanceID)
@@ -276,9 +279,14 @@ func resourceIBMKmsInstancePolicyDelete(context context.Context, d *schema.Resou
 
 }
 
+// policyCreateOrUpdate sets the configured policies on create, and only the changed ones on update,
+// so that an update does not overwrite the policies that were changed outside of Terraform.
 func policyCreateOrUpdate(context context.Context, d *schema.ResourceData, kpAPI *kp.Client) error {
 	var mulPolicy kp.MultiplePolicies
-	if dualAuthDeleteInstancePolicy, ok := d.GetOk("dual_auth_delete"); ok {
+	isPolicySet := func(policy string) bool {
+		return d.Id() == "" || d.HasChange(policy)
+	}
+	if dualAuthDeleteInstancePolicy, ok := d.GetOk("dual_auth_delete"); ok && isPolicySet("dual_auth_delete") {
 		dualAuthDeleteInstancePolicyList := dualAuthDeleteInstancePolicy.([]interface{})
 		if len(dualAuthDeleteInstancePolicyList) != 0 {
 			mulPolicy.DualAuthDelete = &kp.BasicPolicyData{
@@ -286,7 +294,7 @@ func policyCreateOrUpdate(context context.Context, d *schema.ResourceData, kpAPI
 			}
 		}
 	}
-	if rotationInstancePolicy, ok := d.GetOk("rotation"); ok {
+	if rotationInstancePolicy, ok := d.GetOk("rotation"); ok && isPolicySet("rotation") {
 		rotationInstancePolicyList := rotationInstancePolicy.([]interface{})
 		if len(rotationInstancePolicyList) != 0 {
 			iM := rotationInstancePolicyList[0].(map[string]interface{})["interval_month"].(int)
@@ -306,7 +314,7 @@ func policyCreateOrUpdate(context context.Context, d *schema.ResourceData, kpAPI
 
 		}
 	}
-	if metricsInstancePolicy, ok := d.GetOk("metrics"); ok {
+	if metricsInstancePolicy, ok := d.GetOk("metrics"); ok && isPolicySet("metrics") {
 		metricsInstancePolicyList := metricsInstancePolicy.([]interface{})
 		if len(metricsInstancePolicyList) != 0 {
 			mulPolicy.Metrics = &kp.BasicPolicyData{
@@ -314,7 +322,7 @@ func policyCreateOrUpdate(context context.Context, d *schema.ResourceData, kpAPI
 			}
 		}
 	}
-	if kciaip, ok := d.GetOk("key_create_import_access"); ok {
+	if kciaip, ok := d.GetOk("key_create_import_access"); ok && isPolicySet("key_create_import_access") {
 		kciaipList := kciaip.([]interface{})
 		if len(kciaipList) != 0 {
 			mulPolicy.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{
@@ -85,6 +85,12 @@ func TestAccIBMKMSInstancePolicy_metrics_check(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "metrics.0.enabled", "true"),
 				),
 			},
+			{
+				Config: testAccCheckIBMKmsInstancePolicyMetricCheck(instanceName, !metrics),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "metrics.0.enabled", "false"),
+				),
+			},
 		},
 	})
 }
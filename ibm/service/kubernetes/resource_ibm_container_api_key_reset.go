@@ -5,18 +5,26 @@ package kubernetes
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// apiKeyResetImported is the reset_api_key value of an imported resource, so that the first apply after the import
+// records reset_api_key and keepers from the configuration without resetting the api key
+const apiKeyResetImported = -1
+
 func ResourceIBMContainerAPIKeyReset() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIBMContainerAPIKeyResetUpdate,
 		Read:   resourceIBMContainerAPIKeyResetRead,
 		Update: resourceIBMContainerAPIKeyResetUpdate,
 		Delete: resourceIBMContainerAPIKeyResetdelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMContainerAPIKeyResetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -29,6 +37,7 @@ func ResourceIBMContainerAPIKeyReset() *schema.Resource {
 				Type:        schema.TypeString,
 				ForceNew:    true,
 				Optional:    true,
+				Computed:    true,
 				Description: "ID of Resource Group",
 			},
 			"reset_api_key": {
@@ -37,13 +46,23 @@ func ResourceIBMContainerAPIKeyReset() *schema.Resource {
 				Description: "Determines if apikey has to be reset or not",
 				Default:     1,
 			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will reset the api key",
+			},
 		},
 	}
 }
 
 func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("reset_api_key") {
+	if oldReset, _ := d.GetChange("reset_api_key"); !d.IsNewResource() && oldReset.(int) == apiKeyResetImported {
+		return nil
+	}
+
+	if d.IsNewResource() || d.HasChange("reset_api_key") || d.HasChange("keepers") {
 		apikeyClient, err := meta.(conns.ClientSession).ContainerAPI()
 		if err != nil {
 			return err
@@ -73,8 +92,19 @@ func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interfac
 	return nil
 }
 func resourceIBMContainerAPIKeyResetRead(d *schema.ResourceData, meta interface{}) error {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of region/resourceGroupID", d.Id())
+	}
+	d.Set("region", parts[0])
+	d.Set("resource_group_id", parts[1])
 	return nil
 }
+
+func resourceIBMContainerAPIKeyResetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("reset_api_key", apiKeyResetImported)
+	return []*schema.ResourceData{d}, nil
+}
 func resourceIBMContainerAPIKeyResetdelete(d *schema.ResourceData, meta interface{}) error {
 	d.SetId("")
 	return nil
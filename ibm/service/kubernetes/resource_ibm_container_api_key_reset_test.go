@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerAPIKeyResetBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerAPIKeyResetBasic("v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_api_key_reset.reset", "region", acc.CsRegion),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_api_key_reset.reset", "resource_group_id"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_api_key_reset.reset", "keepers.rotation", "v1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerAPIKeyResetBasic("v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_api_key_reset.reset", "keepers.rotation", "v2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_api_key_reset.reset",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keepers", "reset_api_key"},
+			},
+			{
+				ResourceName:       "ibm_container_api_key_reset.reset",
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccCheckIBMContainerAPIKeyResetBasic("v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_api_key_reset.reset", "reset_api_key", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_api_key_reset.reset", "keepers.rotation", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerAPIKeyResetBasic(rotation string) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_api_key_reset" "reset" {
+		region = "%s"
+		keepers = {
+			rotation = "%s"
+		}
+	}`, acc.CsRegion, rotation)
+}
@@ -142,7 +142,7 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentCreate(context context.Context
 		return diag.FromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", clusterNameorID, workerID, volumeattached.Id))
-	_, attachErr := waitforVolumetoAttach(d, meta)
+	_, attachErr := waitforVolumetoAttach(context, d, meta)
 	if attachErr != nil {
 		return diag.FromErr(attachErr)
 	}
@@ -218,7 +218,7 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentDelete(context context.Context
 		return diag.FromErr(fmt.Errorf("[ERROR] Failed to delete the volume attachment: %s", deleteErr))
 	}
 
-	_, err = waitForStorageAttachmentDelete(d, meta)
+	_, err = waitForStorageAttachmentDelete(context, d, meta)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for storage attachment (%s) to be deleted: %s", d.Id(), err))
 	}
@@ -259,7 +259,7 @@ func resourceIBMContainerVpcWorkerVolumeAttachmentExists(d *schema.ResourceData,
 	return true, nil
 }
 
-func waitforVolumetoAttach(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitforVolumetoAttach(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -301,10 +301,10 @@ func waitforVolumetoAttach(d *schema.ResourceData, meta interface{}) (interface{
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.WaitForStateContext(context)
 }
 
-func waitForStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForStorageAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -346,5 +346,5 @@ func waitForStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) (i
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
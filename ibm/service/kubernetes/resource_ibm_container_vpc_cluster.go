@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"strings"
 	"time"
@@ -48,6 +49,9 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMContainerVpcClusterSubnetsValidate(diff, v)
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -168,19 +172,21 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 			},
 
 			"service_subnet": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Custom subnet CIDR to provide private IP addresses for services",
-				Computed:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "Custom subnet CIDR to provide private IP addresses for services",
+				Computed:     true,
 			},
 
 			"pod_subnet": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Custom subnet CIDR to provide private IP addresses for pods",
-				Computed:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "Custom subnet CIDR to provide private IP addresses for pods",
+				Computed:     true,
 			},
 
 			"worker_count": {
@@ -1152,6 +1158,81 @@ func vpcClient(meta interface{}) (*vpcv1.VpcV1, error) {
 	sess, err := meta.(conns.ClientSession).VpcV1API()
 	return sess, err
 }
+
+// resourceIBMContainerVpcClusterSubnetsValidate checks at plan time that the
+// custom pod and service subnets do not overlap each other or the address
+// prefixes of the cluster VPC.
+func resourceIBMContainerVpcClusterSubnetsValidate(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("pod_subnet") && !diff.HasChange("service_subnet") {
+		return nil
+	}
+	subnets := map[string]*net.IPNet{}
+	for _, key := range []string{"pod_subnet", "service_subnet"} {
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		if v, ok := diff.GetOk(key); ok {
+			_, ipNet, err := net.ParseCIDR(v.(string))
+			if err != nil {
+				return fmt.Errorf("[ERROR] %s %s is not a valid CIDR: %s", key, v, err)
+			}
+			subnets[key] = ipNet
+		}
+	}
+	if len(subnets) == 0 {
+		return nil
+	}
+	if pod, ok := subnets["pod_subnet"]; ok {
+		if service, ok := subnets["service_subnet"]; ok && cidrsOverlap(pod, service) {
+			return fmt.Errorf("[ERROR] pod_subnet %s overlaps service_subnet %s", pod, service)
+		}
+	}
+	if !diff.NewValueKnown("vpc_id") {
+		return nil
+	}
+
+	vpcID := diff.Get("vpc_id").(string)
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	start := ""
+	for {
+		listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
+		listVpcAddressPrefixesOptions.SetVPCID(vpcID)
+		if start != "" {
+			listVpcAddressPrefixesOptions.Start = &start
+		}
+		addressPrefixCollection, response, err := sess.ListVPCAddressPrefixes(listVpcAddressPrefixesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing address prefixes of VPC %s: %s\n%s", vpcID, err, response)
+		}
+		for _, addressPrefix := range addressPrefixCollection.AddressPrefixes {
+			if addressPrefix.CIDR == nil {
+				continue
+			}
+			_, prefix, err := net.ParseCIDR(*addressPrefix.CIDR)
+			if err != nil {
+				continue
+			}
+			for key, subnet := range subnets {
+				if cidrsOverlap(subnet, prefix) {
+					return fmt.Errorf("[ERROR] %s %s overlaps address prefix %s of VPC %s", key, subnet, *addressPrefix.CIDR, vpcID)
+				}
+			}
+		}
+		start = flex.GetNext(addressPrefixCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return nil
+}
+
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
 func isWaitForLBDeleted(lbc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
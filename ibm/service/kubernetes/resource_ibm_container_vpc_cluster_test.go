@@ -6,6 +6,7 @@ package kubernetes_test
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -125,6 +126,20 @@ func TestAccIBMContainerVpcClusterImageSecuritySetting(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerVpcClusterSubnetOverlap(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMContainerVpcClusterSubnets(clusterName, "172.30.0.0/16", "172.30.0.0/24"),
+				ExpectError: regexp.MustCompile(`pod_subnet 172.30.0.0/16 overlaps service_subnet 172.30.0.0/24`),
+			},
+		},
+	})
+}
+
 func TestAccIBMContainerVpcClusterDedicatedHost(t *testing.T) {
 	clusterName := fmt.Sprintf("tf-vpc-cluster-dhost-%d", acctest.RandIntRange(10, 100))
 	hostPoolID := acc.HostPoolID
@@ -384,6 +399,23 @@ func testAccCheckIBMContainerVpcClusterImageSecuritySetting(name, setting string
 	  }`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.SubnetID, setting)
 }
 
+func testAccCheckIBMContainerVpcClusterSubnets(name, podSubnet, serviceSubnet string) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_cluster" "testacc_vpc_cluster" {
+		name              = "%s"
+		vpc_id            = "%s"
+		flavor            = "bx2.2x8"
+		worker_count      = "1"
+		resource_group_id = "%s"
+		zones {
+			subnet_id = "%s"
+			name      = "us-south-1"
+		  }
+		pod_subnet     = "%s"
+		service_subnet = "%s"
+	  }`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.SubnetID, podSubnet, serviceSubnet)
+}
+
 func testAccCheckIBMContainerVpcClusterDedicatedHostSetting(name, vpcID, flavor, subnetID, rgroupID, hostpoolID string) string {
 	return fmt.Sprintf(`
 	resource "ibm_container_vpc_cluster" "testacc_dhost_vpc_cluster" {
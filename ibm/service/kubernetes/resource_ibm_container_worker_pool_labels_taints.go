@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMContainerWorkerPoolLabelsTaints() *schema.Resource {
+
+	return &schema.Resource{
+		Create:   resourceIBMContainerWorkerPoolLabelsTaintsCreate,
+		Read:     resourceIBMContainerWorkerPoolLabelsTaintsRead,
+		Update:   resourceIBMContainerWorkerPoolLabelsTaintsUpdate,
+		Delete:   resourceIBMContainerWorkerPoolLabelsTaintsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_worker_pool_labels_taints",
+					"cluster"),
+			},
+
+			"worker_pool": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Worker pool name or ID",
+			},
+
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels of the worker pool. Labels that are not listed are removed from the worker pool",
+			},
+
+			"taints": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Taints of the worker pool. Taints that are not listed are removed from the worker pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key for taint",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value for taint.",
+						},
+						"effect": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Effect for taint. Accepted values are NoSchedule, PreferNoSchedule and NoExecute.",
+							ValidateFunc: validate.InvokeValidator(
+								"ibm_container_worker_pool_labels_taints",
+								"effect"),
+						},
+					},
+				},
+			},
+
+			"resource_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "ID of the resource group.",
+				ForceNew:         true,
+				DiffSuppressFunc: flex.ApplyOnce,
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerWorkerPoolLabelsTaintsValidator() *validate.ResourceValidator {
+	tainteffects := "NoSchedule,PreferNoSchedule,NoExecute"
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "effect",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              tainteffects})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+
+	containerWorkerPoolLabelsTaintsValidator := validate.ResourceValidator{ResourceName: "ibm_container_worker_pool_labels_taints", Schema: validateSchema}
+	return &containerWorkerPoolLabelsTaintsValidator
+}
+
+func resourceIBMContainerWorkerPoolLabelsTaintsCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	workerPool := d.Get("worker_pool").(string)
+
+	err := updateWorkerPoolLabelsTaints(d, meta, cluster, workerPool, true, true)
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", cluster, workerPool))
+
+	return resourceIBMContainerWorkerPoolLabelsTaintsRead(d, meta)
+}
+
+func resourceIBMContainerWorkerPoolLabelsTaintsRead(d *schema.ResourceData, meta interface{}) error {
+	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of clusterNameOrID/workerPoolNameOrID", d.Id())
+	}
+	cluster := parts[0]
+	workerPoolID := parts[1]
+
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	workerPool, err := wpClient.WorkerPools().GetWorkerPool(cluster, workerPoolID, targetEnv)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Worker pool %s of cluster %s is not found, removing its labels and taints from state", workerPoolID, cluster)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving worker pool %s of cluster %s: %s", workerPoolID, cluster, err)
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("worker_pool", workerPoolID)
+	d.Set("labels", flex.IgnoreSystemLabels(workerPool.Labels))
+	d.Set("taints", flattenWorkerPoolTaints(workerPool))
+	return nil
+}
+
+func resourceIBMContainerWorkerPoolLabelsTaintsUpdate(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = updateWorkerPoolLabelsTaints(d, meta, parts[0], parts[1], d.HasChange("labels"), d.HasChange("taints"))
+	if err != nil {
+		return err
+	}
+
+	return resourceIBMContainerWorkerPoolLabelsTaintsRead(d, meta)
+}
+
+func resourceIBMContainerWorkerPoolLabelsTaintsDelete(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// removing the resource clears the labels and taints it manages
+	d.Set("labels", map[string]interface{}{})
+	d.Set("taints", []interface{}{})
+	err = updateWorkerPoolLabelsTaints(d, meta, parts[0], parts[1], true, true)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateWorkerPoolLabelsTaints replaces the labels and taints of the worker pool with the configured ones
+func updateWorkerPoolLabelsTaints(d *schema.ResourceData, meta interface{}, cluster, workerPool string, updateLabels, updateTaints bool) error {
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if updateLabels {
+		labels := make(map[string]string)
+		if l, ok := d.GetOk("labels"); ok {
+			for k, v := range l.(map[string]interface{}) {
+				labels[k] = v.(string)
+			}
+		}
+
+		ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
+		if err != nil {
+			return err
+		}
+		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
+
+		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(cluster, workerPool, labels, Env)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the labels of worker pool %s: %w", workerPool, err)
+		}
+	}
+
+	if updateTaints {
+		taintParam := expandWorkerPoolTaints(d, meta, cluster, workerPool)
+
+		ClusterClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
+		err = ClusterClient.WorkerPools().UpdateWorkerPoolTaints(taintParam, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the taints of worker pool %s: %w", workerPool, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerWorkerPoolLabelsTaintsBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-wp-labels-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerWorkerPoolLabelsTaintsConfig(name, "sre", "NoSchedule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_labels_taints.scheduling", "labels.%", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_labels_taints.scheduling", "labels.team", "sre"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_labels_taints.scheduling", "taints.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerWorkerPoolLabelsTaintsConfig(name, "platform", "NoExecute"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_labels_taints.scheduling", "labels.team", "platform"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_labels_taints.scheduling", "taints.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_container_worker_pool_labels_taints.scheduling",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"resource_group_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerWorkerPoolLabelsTaintsConfig(name, team, effect string) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+		cluster           = "%[1]s"
+		flavor            = "bx2.4x16"
+		worker_pool_name  = "%[2]s"
+		zones {
+		  subnet_id = "%[3]s"
+		  name      = "us-south-1"
+		}
+		worker_count      = 1
+		vpc_id            = "%[4]s"
+		resource_group_id = "%[5]s"
+
+		lifecycle {
+		  ignore_changes = [labels, taints]
+		}
+	}
+
+	resource "ibm_container_worker_pool_labels_taints" "scheduling" {
+		cluster           = "%[1]s"
+		worker_pool       = ibm_container_vpc_worker_pool.test_pool.worker_pool_id
+		resource_group_id = "%[5]s"
+		labels = {
+		  "team" = "%[6]s"
+		}
+		taints {
+		  key    = "dedicated"
+		  value  = "%[6]s"
+		  effect = "%[7]s"
+		}
+	}
+	`, acc.IksClusterID, name, acc.IksClusterSubnetID, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, team, effect)
+}
@@ -5,6 +5,8 @@ package power
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -38,6 +40,30 @@ func DataSourceIBMPICatalogImages() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"operating_system": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Only list the images with this operating system, for example aix, ibmi, rhel or sles. The match is case-insensitive.",
+			},
+			"image_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Only list the images of this type, for example stock, stock-sap or stock-vtl.",
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Only list the images on this storage type (tier), for example tier1 or tier3.",
+			},
+			"architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Only list the images of this architecture, for example ppc64.",
+			},
 			"images": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -129,14 +155,45 @@ func dataSourceIBMPICatalogImagesRead(ctx context.Context, d *schema.ResourceDat
 	if v, ok := d.GetOk("vtl"); ok {
 		includeVTL = v.(bool)
 	}
+	operatingSystem := d.Get("operating_system").(string)
+	imageType := d.Get("image_type").(string)
+	storageType := d.Get("storage_type").(string)
+	architecture := d.Get("architecture").(string)
+	// SAP and VTL images are only returned when asked for, so filtering on their type includes them.
+	if strings.HasPrefix(imageType, "stock-sap") {
+		includeSAP = true
+	}
+	if strings.HasPrefix(imageType, "stock-vtl") {
+		includeVTL = true
+	}
 	imageC := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 	stockImages, err := imageC.GetAllStockImages(includeSAP, includeVTL)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// Sort by name, then ID, so that the images keep their index across regions and runs.
+	sort.SliceStable(stockImages.Images, func(a, b int) bool {
+		nameA, nameB := *stockImages.Images[a].Name, *stockImages.Images[b].Name
+		if nameA != nameB {
+			return nameA < nameB
+		}
+		return *stockImages.Images[a].ImageID < *stockImages.Images[b].ImageID
+	})
+
 	images := make([]map[string]interface{}, 0)
 	for _, i := range stockImages.Images {
+		if storageType != "" && (i.StorageType == nil || *i.StorageType != storageType) {
+			continue
+		}
+		if operatingSystem != "" || imageType != "" || architecture != "" {
+			if i.Specifications == nil ||
+				(operatingSystem != "" && !strings.EqualFold(i.Specifications.OperatingSystem, operatingSystem)) ||
+				(imageType != "" && i.Specifications.ImageType != imageType) ||
+				(architecture != "" && i.Specifications.Architecture != architecture) {
+				continue
+			}
+		}
 		image := make(map[string]interface{})
 		image["image_id"] = *i.ImageID
 		image["name"] = *i.Name
@@ -48,6 +48,17 @@ func testAccCheckIBMPICatalogImagesDataSourceSAP_And_VTLConfig() string {
 	`, acc.Pi_cloud_instance_id)
 }
 
+func testAccCheckIBMPICatalogImagesDataSourceFilterConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_pi_catalog_images" "power_catalog_images_filter" {
+		pi_cloud_instance_id = "%s"
+		operating_system     = "aix"
+		image_type           = "stock"
+		storage_type         = "tier3"
+	}
+	`, acc.Pi_cloud_instance_id)
+}
+
 func TestAccIBMPICatalogImagesDataSourceBasic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acc.TestAccPreCheck(t) },
@@ -107,3 +118,20 @@ func TestAccIBMPICatalogImagesDataSourceSAPAndVTL(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMPICatalogImagesDataSourceFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPICatalogImagesDataSourceFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_catalog_images.power_catalog_images_filter", "id"),
+					resource.TestCheckResourceAttr("data.ibm_pi_catalog_images.power_catalog_images_filter", "images.0.operating_system", "aix"),
+					resource.TestCheckResourceAttr("data.ibm_pi_catalog_images.power_catalog_images_filter", "images.0.storage_type", "tier3"),
+				),
+			},
+		},
+	})
+}
@@ -23,6 +23,12 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
+// FIXME: Add virtual serial number support (assign, retain, and restore on
+// instances, and an ibm_pi_virtual_serial_number resource) when
+// power-go-client is at v1.9.0 or later, the first release with the
+// virtual serial number client. v1.9.0 requires platform-services-go-sdk
+// v0.69.1, which no longer ships atrackerv1, so the atracker v1 resources
+// must be migrated first.
 func ResourceIBMPIInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPIInstanceCreate,
@@ -451,6 +457,8 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(helpers.PIInstanceImageId, powervmdata.ImageID)
 	if *powervmdata.PlacementGroup != "none" {
 		d.Set(helpers.PIPlacementGroupID, powervmdata.PlacementGroup)
+	} else {
+		d.Set(helpers.PIPlacementGroupID, "")
 	}
 	d.Set(Arg_PIInstanceSharedProcessorPool, powervmdata.SharedProcessorPool)
 	d.Set(Attr_PIInstanceSharedProcessorPoolID, powervmdata.SharedProcessorPoolID)
@@ -481,6 +489,9 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("max_processors", powervmdata.Maxproc)
 	d.Set("max_memory", powervmdata.Maxmem)
 	d.Set("pin_policy", powervmdata.PinPolicy)
+	if powervmdata.PinPolicy != "" {
+		d.Set(helpers.PIInstancePinPolicy, powervmdata.PinPolicy)
+	}
 	d.Set("operating_system", powervmdata.OperatingSystem)
 	if powervmdata.OsType != nil {
 		d.Set("os_type", powervmdata.OsType)
@@ -545,6 +556,20 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange(helpers.PIInstancePinPolicy) {
+		body := &models.PVMInstanceUpdate{
+			PinPolicy: models.PinPolicy(d.Get(helpers.PIInstancePinPolicy).(string)),
+		}
+		_, err = client.Update(instanceID, body)
+		if err != nil {
+			return diag.Errorf("failed to update the lpar with the change for pin policy: %v", err)
+		}
+		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange(helpers.PIInstanceProcType) {
 
 		// Stop the lpar
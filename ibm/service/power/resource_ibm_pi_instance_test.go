@@ -410,3 +410,57 @@ func testAccIBMPIInstanceMixedStorage(name string) string {
 	}
 	`, acc.Pi_cloud_instance_id, name)
 }
+
+func TestAccIBMPIInstancePinPolicy(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstancePinPolicyConfig(name, "none"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_pin_policy", "none"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstancePinPolicyConfig(name, "soft"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_pin_policy", "soft"),
+					resource.TestCheckResourceAttr(instanceRes, "pin_policy", "soft"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstancePinPolicyConfig(name, pinPolicy string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_image_name        = "%[3]s"
+		pi_cloud_instance_id = "%[1]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_memory            = "2"
+		pi_processors        = "0.25"
+		pi_instance_name     = "%[2]s"
+		pi_proc_type         = "shared"
+		pi_image_id          = data.ibm_pi_image.power_image.id
+		pi_sys_type          = "s922"
+		pi_cloud_instance_id = "%[1]s"
+		pi_storage_pool      = data.ibm_pi_image.power_image.storage_pool
+		pi_pin_policy        = "%[5]s"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, pinPolicy)
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func DataIBMContainerRegistryImageManifest() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryImageManifestRead,
+
+		Schema: map[string]*schema.Schema{
+			"image": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The full IBM Cloud registry path to the image, for example us.icr.io/namespace/repository:tag",
+			},
+			"manifest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The manifest of the image, in JSON format",
+			},
+			"manifest_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The media type of the image manifest",
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the image",
+			},
+			"architecture": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CPU architecture of the image",
+			},
+			"os": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating system of the image",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the image was created",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the image in bytes",
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryImageManifestRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image := d.Get("image").(string)
+
+	getImageManifestOptions := &containerregistryv1.GetImageManifestOptions{}
+	getImageManifestOptions.SetImage(image)
+	manifest, response, err := containerRegistryClient.GetImageManifestWithContext(context, getImageManifestOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the manifest of image %s: %s\n%s", image, err, response))
+	}
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling the manifest of image %s: %s", image, err))
+	}
+
+	inspectImageOptions := &containerregistryv1.InspectImageOptions{}
+	inspectImageOptions.SetImage(image)
+	inspection, response, err := containerRegistryClient.InspectImageWithContext(context, inspectImageOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error inspecting image %s: %s\n%s", image, err, response))
+	}
+
+	d.SetId(image)
+	d.Set("manifest", string(manifestJSON))
+	d.Set("manifest_type", inspection.ManifestType)
+	d.Set("image_id", inspection.ID)
+	d.Set("architecture", inspection.Architecture)
+	d.Set("os", inspection.Os)
+	d.Set("created", inspection.Created)
+	d.Set("size", inspection.Size)
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrImageManifestDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrImageManifestDataSourceConfig(acc.CrImage),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cr_image_manifest.manifest", "id", acc.CrImage),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_image_manifest.manifest", "manifest"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_image_manifest.manifest", "image_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrImageManifestDataSourceConfig(image string) string {
+	return fmt.Sprintf(`
+	data "ibm_cr_image_manifest" "manifest" {
+		image = "%s"
+	}
+`, image)
+}
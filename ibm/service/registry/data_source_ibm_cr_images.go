@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func DataIBMContainerRegistryImages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The namespace to list the images of",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the images of this repository, for example us.icr.io/namespace/repository",
+			},
+			"tag_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Regular expression that a tag of the image must match. Images without a matching tag are not listed",
+			},
+			"include_manifest_lists": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Includes tags that reference multi-architecture manifest lists",
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Container Registry images",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the image",
+						},
+						"repo_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The tagged names of the image",
+						},
+						"repo_digests": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The digest references of the image",
+						},
+						"created": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "When the image was created, in seconds since the epoch",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the image in bytes",
+						},
+						"manifest_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The media type of the image manifest",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The labels of the image",
+						},
+						"vulnerable": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Vulnerability Advisor status of the image",
+						},
+						"vulnerability_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vulnerabilities found in the image that are not exempt",
+						},
+						"configuration_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of configuration issues found in the image that are not exempt",
+						},
+						"issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total number of issues found in the image that are not exempt",
+						},
+						"exempt_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of issues found in the image that are exempt",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryImagesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(conns.ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var tagRegex *regexp.Regexp
+	if v, ok := d.GetOk("tag_regex"); ok {
+		tagRegex, err = regexp.Compile(v.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Invalid tag_regex %s: %s", v.(string), err))
+		}
+	}
+
+	listImagesOptions := &containerregistryv1.ListImagesOptions{}
+	listImagesOptions.SetNamespace(d.Get("namespace").(string))
+	listImagesOptions.SetIncludeManifestLists(d.Get("include_manifest_lists").(bool))
+	listImagesOptions.SetVulnerabilities(true)
+	if v, ok := d.GetOk("repository"); ok {
+		listImagesOptions.SetRepository(v.(string))
+	}
+
+	imageList, response, err := containerRegistryClient.ListImagesWithContext(context, listImagesOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing images of namespace %s: %s\n%s", d.Get("namespace").(string), err, response))
+	}
+
+	images := []map[string]interface{}{}
+	for _, image := range imageList {
+		if tagRegex != nil && !imageTagMatches(image.RepoTags, tagRegex) {
+			continue
+		}
+		images = append(images, dataIBMContainerRegistryImageToMap(image))
+	}
+	if err = d.Set("images", images); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting images: %s", err))
+	}
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
+
+// imageTagMatches reports whether the tag part of one of the repo tags matches the regular expression
+func imageTagMatches(repoTags []string, tagRegex *regexp.Regexp) bool {
+	for _, repoTag := range repoTags {
+		tag := repoTag[strings.LastIndex(repoTag, ":")+1:]
+		if tagRegex.MatchString(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func dataIBMContainerRegistryImageToMap(image containerregistryv1.RemoteAPIImage) map[string]interface{} {
+	imageMap := map[string]interface{}{}
+	imageMap["id"] = image.ID
+	imageMap["repo_tags"] = image.RepoTags
+	imageMap["repo_digests"] = image.RepoDigests
+	imageMap["created"] = image.Created
+	imageMap["size"] = image.Size
+	imageMap["manifest_type"] = image.ManifestType
+	imageMap["labels"] = image.Labels
+	imageMap["vulnerable"] = image.Vulnerable
+	imageMap["vulnerability_count"] = image.VulnerabilityCount
+	imageMap["configuration_issue_count"] = image.ConfigurationIssueCount
+	imageMap["issue_count"] = image.IssueCount
+	imageMap["exempt_issue_count"] = image.ExemptIssueCount
+	return imageMap
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrImagesDataSourceBasic(t *testing.T) {
+	// IBM_CR_IMAGE has the format <registry>/<namespace>/<repository>:<tag>
+	parts := strings.Split(acc.CrImage, "/")
+	namespace := ""
+	tag := ""
+	if len(parts) == 3 {
+		namespace = parts[1]
+		tag = parts[2][strings.LastIndex(parts[2], ":")+1:]
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrImagesDataSourceConfig(namespace, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "images.0.id"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "images.0.vulnerable"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "images.0.issue_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrImagesDataSourceConfig(namespace, tag string) string {
+	return fmt.Sprintf(`
+	data "ibm_cr_images" "images" {
+		namespace = "%s"
+		tag_regex = "^%s$"
+	}
+`, namespace, tag)
+}
@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMCatalogPricing() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCatalogPricingRead,
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Description: "The name of the service offering like cloud-object-storage, kms etc",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"plan": {
+				Description: "The name of the plan type supported by service. You can retrieve the value by running the ibmcloud catalog service <servicename> command",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"location": {
+				Description: "The location where the plan is deployed",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"country": {
+				Description: "The three letter country code of the amounts to return, for example USA. All countries are returned if not set",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"plan_id": {
+				Description: "The ID of the plan",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"deployment_id": {
+				Description: "The ID of the deployment of the plan in the location",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The pricing type, for example free, paid or subscription",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"origin": {
+				Description: "The source of the pricing information",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metrics": {
+				Description: "The cost metrics of the plan",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"part_ref": {
+							Description: "The part reference",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metric_id": {
+							Description: "The metric ID or part number",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tier_model": {
+							Description: "The tier model",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"charge_unit": {
+							Description: "The unit to charge",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"charge_unit_name": {
+							Description: "The name of the charge unit",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"charge_unit_display_name": {
+							Description: "The display name of the charge unit",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"charge_unit_quantity": {
+							Description: "The quantity of the charge unit",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"resource_display_name": {
+							Description: "The display name of the resource",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"usage_cap_qty": {
+							Description: "The usage limit of the metric",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"amounts": {
+							Description: "The prices of the metric in each country",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"country": {
+										Description: "The three letter country code",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"currency": {
+										Description: "The currency",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"prices": {
+										Description: "The price of each quantity tier",
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"quantity_tier": {
+													Description: "The quantity the price applies up to",
+													Type:        schema.TypeInt,
+													Computed:    true,
+												},
+												"price": {
+													Description: "The price per charge unit",
+													Type:        schema.TypeFloat,
+													Computed:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCatalogPricingRead(d *schema.ResourceData, meta interface{}) error {
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return err
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	serviceName := d.Get("service").(string)
+	plan := d.Get("plan").(string)
+	location := d.Get("location").(string)
+
+	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+	}
+	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+	}
+	deployments, err := rsCatRepo.ListDeployments(servicePlan)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err)
+	}
+	deployments, supportedLocations := FilterDeployments(deployments, location)
+	if len(deployments) == 0 {
+		locationList := make([]string, 0, len(supportedLocations))
+		for l := range supportedLocations {
+			locationList = append(locationList, l)
+		}
+		return fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q", plan, location, locationList)
+	}
+
+	globalCatalogClient, err := meta.(conns.ClientSession).GlobalCatalogV1API()
+	if err != nil {
+		return err
+	}
+	getPricingOptions := &globalcatalogv1.GetPricingOptions{}
+	getPricingOptions.SetID(deployments[0].ID)
+	pricing, response, err := globalCatalogClient.GetPricing(getPricingOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving pricing of deployment %s: %s\n%s", deployments[0].ID, err, response)
+	}
+
+	d.SetId(deployments[0].ID)
+	d.Set("plan_id", servicePlan)
+	d.Set("deployment_id", deployments[0].ID)
+	d.Set("type", core.StringNilMapper(pricing.Type))
+	d.Set("origin", core.StringNilMapper(pricing.Origin))
+	d.Set("metrics", flattenCatalogPricingMetrics(pricing.Metrics, d.Get("country").(string)))
+
+	return nil
+}
+
+func flattenCatalogPricingMetrics(metrics []globalcatalogv1.Metrics, country string) []interface{} {
+	out := make([]interface{}, 0, len(metrics))
+	for _, metric := range metrics {
+		m := map[string]interface{}{
+			"part_ref":                 core.StringNilMapper(metric.PartRef),
+			"metric_id":                core.StringNilMapper(metric.MetricID),
+			"tier_model":               core.StringNilMapper(metric.TierModel),
+			"charge_unit":              core.StringNilMapper(metric.ChargeUnit),
+			"charge_unit_name":         core.StringNilMapper(metric.ChargeUnitName),
+			"charge_unit_display_name": core.StringNilMapper(metric.ChargeUnitDisplayName),
+			"charge_unit_quantity":     core.StringNilMapper(metric.ChargeUnitQuantity),
+			"resource_display_name":    core.StringNilMapper(metric.ResourceDisplayName),
+		}
+		if metric.UsageCapQty != nil {
+			m["usage_cap_qty"] = int(*metric.UsageCapQty)
+		}
+		amounts := make([]interface{}, 0, len(metric.Amounts))
+		for _, amount := range metric.Amounts {
+			if country != "" && (amount.Country == nil || *amount.Country != country) {
+				continue
+			}
+			prices := make([]interface{}, 0, len(amount.Prices))
+			for _, price := range amount.Prices {
+				p := map[string]interface{}{}
+				if price.QuantityTier != nil {
+					p["quantity_tier"] = int(*price.QuantityTier)
+				}
+				if price.Price != nil {
+					p["price"] = *price.Price
+				}
+				prices = append(prices, p)
+			}
+			amounts = append(amounts, map[string]interface{}{
+				"country":  core.StringNilMapper(amount.Country),
+				"currency": core.StringNilMapper(amount.Currency),
+				"prices":   prices,
+			})
+		}
+		m["amounts"] = amounts
+		out = append(out, m)
+	}
+	return out
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCatalogPricingDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCatalogPricingDataSourceConfig("kms", "tiered-pricing", "us-south"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_catalog_pricing.testacc_ds_catalog_pricing", "plan_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_catalog_pricing.testacc_ds_catalog_pricing", "deployment_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_catalog_pricing.testacc_ds_catalog_pricing", "metrics.0.metric_id"),
+					resource.TestCheckResourceAttr("data.ibm_catalog_pricing.testacc_ds_catalog_pricing", "metrics.0.amounts.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_catalog_pricing.testacc_ds_catalog_pricing", "metrics.0.amounts.0.country", "USA"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCatalogPricingDataSource_invalid_location(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMCatalogPricingDataSourceConfig("kms", "tiered-pricing", "abc"),
+				ExpectError: regexp.MustCompile(`No deployment found for service plan`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCatalogPricingDataSourceConfig(service, plan, location string) string {
+	return fmt.Sprintf(`
+
+data "ibm_catalog_pricing" "testacc_ds_catalog_pricing" {
+    service  = "%s"
+    plan     = "%s"
+    location = "%s"
+    country  = "USA"
+}`, service, plan, location)
+
+}
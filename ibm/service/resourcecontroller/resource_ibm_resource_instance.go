@@ -114,6 +114,13 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				Description: "Arbitrary parameters to pass in Json string format",
 			},
 
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the instance is locked by the resource controller so that it cannot be deleted. The instance must be unlocked, by setting this to false, before it can be destroyed",
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -529,6 +536,14 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	// resources built on the resource instance, such as ibm_cloudant, have no deletion_protection
+	if protect, ok := d.GetOk("deletion_protection"); ok && protect.(bool) {
+		err = setResourceInstanceLock(rsConClient, d.Id(), true)
+		if err != nil {
+			return err
+		}
+	}
+
 	return ResourceIBMResourceInstanceRead(d, meta)
 }
 func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
@@ -637,6 +652,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 	d.Set("locked", instance.Locked)
+	d.Set("deletion_protection", instance.Locked)
 	d.Set("allow_cleanup", instance.AllowCleanup)
 	d.Set("type", instance.Type)
 	d.Set("state", instance.State)
@@ -766,14 +782,17 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
-	}
-
-	_, err = waitForResourceInstanceUpdate(d, meta)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+	wasProtected, protect := d.GetChange("deletion_protection")
+	if d.HasChanges("name", "plan", "service_endpoints", "parameters", "parameters_json") {
+		err = updateResourceInstance(d, meta, rsConClient, &resourceInstanceUpdate, wasProtected.(bool), protect.(bool))
+		if err != nil {
+			return err
+		}
+	} else if d.HasChange("deletion_protection") {
+		err = setResourceInstanceLock(rsConClient, instanceID, protect.(bool))
+		if err != nil {
+			return err
+		}
 	}
 
 	return ResourceIBMResourceInstanceRead(d, meta)
@@ -785,6 +804,9 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	id := d.Id()
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("[ERROR] Resource instance (%s) has deletion_protection enabled, set it to false and apply before destroying the instance", id)
+	}
 	recursive := true
 	resourceInstanceDelete := rc.DeleteResourceInstanceOptions{
 		ID:        &id,
@@ -834,6 +856,68 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 	return *instance.ID == instanceID, nil
 }
 
+// updateResourceInstance updates the instance and waits for the update. A locked instance rejects
+// updates, so it is unlocked for the time of the update and locked again afterwards if
+// deletion_protection is still set. It is also locked again when the update fails.
+func updateResourceInstance(d *schema.ResourceData, meta interface{}, rsConClient *rc.ResourceControllerV2, resourceInstanceUpdate *rc.UpdateResourceInstanceOptions, wasProtected, protect bool) (err error) {
+	instanceID := *resourceInstanceUpdate.ID
+	if wasProtected {
+		err = setResourceInstanceLock(rsConClient, instanceID, false)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err == nil {
+				return
+			}
+			if lockErr := setResourceInstanceLock(rsConClient, instanceID, true); lockErr != nil {
+				err = fmt.Errorf("%s\n%s", err, lockErr)
+			}
+		}()
+	}
+
+	_, resp, err := rsConClient.UpdateResourceInstance(resourceInstanceUpdate)
+	if err != nil {
+		if d.HasChange("service_endpoints") {
+			return resourceInstanceServiceEndpointsError(d, fmt.Errorf("%s with resp code: %s", err, resp))
+		}
+		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
+	}
+
+	_, err = waitForResourceInstanceUpdate(d, meta)
+	if err != nil {
+		if d.HasChange("service_endpoints") {
+			return resourceInstanceServiceEndpointsError(d, err)
+		}
+		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+	}
+
+	if protect {
+		return setResourceInstanceLock(rsConClient, instanceID, true)
+	}
+	return nil
+}
+
+// setResourceInstanceLock locks or unlocks the instance in the resource controller.
+func setResourceInstanceLock(rsConClient *rc.ResourceControllerV2, id string, locked bool) error {
+	if locked {
+		_, resp, err := rsConClient.LockResourceInstance(&rc.LockResourceInstanceOptions{
+			ID: &id,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error locking resource instance (%s): %s with resp code: %s", id, err, resp)
+		}
+		return nil
+	}
+	_, resp, err := rsConClient.UnlockResourceInstance(&rc.UnlockResourceInstanceOptions{
+		ID: &id,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error unlocking resource instance (%s): %s with resp code: %s", id, err, resp)
+	}
+	return nil
+}
+
 func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -868,6 +952,15 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 	return stateConf.WaitForState()
 }
 
+// resourceInstanceServiceEndpointsError explains a failed in place change of
+// service_endpoints, which not every service broker supports.
+func resourceInstanceServiceEndpointsError(d *schema.ResourceData, err error) error {
+	oldEndpoints, newEndpoints := d.GetChange("service_endpoints")
+	return fmt.Errorf("[ERROR] Error updating resource instance (%s): the %s service did not change service_endpoints from %q to %q in place: %s\n"+
+		"If the service does not support changing the service endpoints of an existing instance, recreate the instance, for example with terraform apply -replace",
+		d.Id(), d.Get("service").(string), oldEndpoints, newEndpoints, err)
+}
+
 func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
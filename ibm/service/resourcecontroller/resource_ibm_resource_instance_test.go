@@ -110,13 +110,21 @@ func TestAccIBMResourceInstanceWithServiceendpoints(t *testing.T) {
 		Steps: []resource.TestStep{
 
 			{
-				Config: testAccCheckIBMResourceInstanceServiceendpoints(serviceName),
+				Config: testAccCheckIBMResourceInstanceServiceendpoints(serviceName, "public-and-private"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIBMResourceInstanceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "name", serviceName),
 					resource.TestCheckResourceAttr(resourceName, "service", "databases-for-postgresql"),
 					resource.TestCheckResourceAttr(resourceName, "plan", "standard"),
 					resource.TestCheckResourceAttr(resourceName, "location", "us-south"),
+					resource.TestCheckResourceAttr(resourceName, "service_endpoints", "public-and-private"),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceServiceendpoints(serviceName, "private"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "service_endpoints", "private"),
 				),
 			},
 		},
@@ -146,6 +154,47 @@ func TestAccIBMResourceInstanceWithResourceGroup(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceInstanceDeletionProtection(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-kms-%d", acctest.RandIntRange(10, 100))
+	updateName := fmt.Sprintf("tf-kms-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceDeletionProtection(serviceName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists("ibm_resource_instance.instance"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "deletion_protection", "true"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "locked", "true"),
+				),
+			},
+			{
+				// the instance is unlocked for the update and locked again
+				Config: testAccCheckIBMResourceInstanceDeletionProtection(updateName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "name", updateName),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "locked", "true"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMResourceInstanceDeletionProtection(updateName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection enabled"),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceDeletionProtection(updateName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "locked", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCOSResourceInstanceOneRatePlan(t *testing.T) {
 	serviceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
 
@@ -200,6 +249,30 @@ func TestAccIBMCOSResourceInstanceWithInvalidPlan(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceInstanceProviderDefaultResourceGroup(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMResourceInstanceProviderDefaultResourceGroup("tf-missing-resource-group", serviceName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("could not be found"),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceProviderDefaultResourceGroup(acc.IsResourceGroupID, serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists("ibm_resource_instance.instance"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "resource_group_id", acc.IsResourceGroupID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMResourceInstanceDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -401,7 +474,7 @@ func testAccCheckIBMResourceInstanceWithResourceGroup(serviceName string) string
 	`, serviceName)
 }
 
-func testAccCheckIBMResourceInstanceServiceendpoints(serviceName string) string {
+func testAccCheckIBMResourceInstanceServiceendpoints(serviceName, serviceEndpoints string) string {
 	return fmt.Sprintf(`
 	
 	resource "ibm_resource_instance" "instance" {
@@ -413,7 +486,7 @@ func testAccCheckIBMResourceInstanceServiceendpoints(serviceName string) string
 		  members_memory_allocation_mb = "4096"
 		}
 	  
-		//service_endpoints = "public-and-private"
+		service_endpoints = "%s"
 		timeouts {
 		  create = "25m"
 		  update = "15m"
@@ -421,5 +494,33 @@ func testAccCheckIBMResourceInstanceServiceendpoints(serviceName string) string
 		}
 	}
 			
-	`, serviceName)
+	`, serviceName, serviceEndpoints)
+}
+
+func testAccCheckIBMResourceInstanceDeletionProtection(serviceName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_resource_instance" "instance" {
+		name                = "%s"
+		service             = "kms"
+		plan                = "tiered-pricing"
+		location            = "us-south"
+		deletion_protection = %t
+	}
+	`, serviceName, deletionProtection)
+}
+
+func testAccCheckIBMResourceInstanceProviderDefaultResourceGroup(resourceGroup, serviceName string) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		default_resource_group = "%s"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name     = "%s"
+		service  = "cloud-object-storage"
+		plan     = "standard"
+		location = "global"
+	}
+	`, resourceGroup, serviceName)
 }
@@ -26,6 +26,8 @@ func ResourceIBMSatelliteEndpoint() *schema.Resource {
 		DeleteContext: resourceIbmSatelliteEndpointDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIbmSatelliteEndpointDNSRecordDiff,
+
 		Schema: map[string]*schema.Schema{
 			"location": {
 				Type:        schema.TypeString,
@@ -219,6 +221,59 @@ func ResourceIBMSatelliteEndpoint() *schema.Resource {
 					},
 				},
 			},
+			"dns_record": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A DNS Services resource record that publishes the client host and port of the endpoint.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The GUID of the DNS Services instance.",
+						},
+						"zone_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the DNS Services zone.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the resource record.",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "CNAME",
+							ValidateFunc: validate.InvokeValidator("ibm_satellite_endpoint", "dns_record_type"),
+							Description:  "The type of the resource record. A CNAME record publishes the client host, an SRV record publishes the client host and port.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     900,
+							Description: "The time to live of the resource record in seconds.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The service name of an SRV record, starting with an underscore.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The protocol of an SRV record, for example tcp.",
+						},
+						"record_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource record.",
+						},
+					},
+				},
+			},
 			"sources": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -386,6 +441,13 @@ func ResourceIBMSatelliteEndpointValidator() *validate.ResourceValidator {
 			MinValue:                   "1",
 			MaxValue:                   "180",
 		},
+		validate.ValidateSchema{
+			Identifier:                 "dns_record_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "CNAME, SRV",
+		},
 	)
 
 	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_satellite_endpoint", Schema: validateSchema}
@@ -450,6 +512,23 @@ func resourceIbmSatelliteEndpointCreate(context context.Context, d *schema.Resou
 
 	d.SetId(fmt.Sprintf("%s/%s", *createEndpointsOptions.LocationID, *endpoint.EndpointID))
 
+	if _, ok := d.GetOk("dns_record"); ok {
+		err = resourceIbmSatelliteEndpointPublishDNSRecord(context, d, meta)
+		if err != nil {
+			// do not leave an endpoint behind whose address was not published
+			deleteEndpointsOptions := &satellitelinkv1.DeleteEndpointsOptions{}
+			deleteEndpointsOptions.SetLocationID(*createEndpointsOptions.LocationID)
+			deleteEndpointsOptions.SetEndpointID(*endpoint.EndpointID)
+			_, response, delErr := satelliteLinkClient.DeleteEndpointsWithContext(context, deleteEndpointsOptions)
+			if delErr != nil {
+				log.Printf("[DEBUG] DeleteEndpointsWithContext failed %s\n%s", delErr, response)
+				return diag.FromErr(fmt.Errorf("%s\nDeleting the endpoint failed as well: %s", err, delErr))
+			}
+			d.SetId("")
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIbmSatelliteEndpointRead(context, d, meta)
 }
 
@@ -901,6 +980,13 @@ func resourceIbmSatelliteEndpointUpdate(context context.Context, d *schema.Resou
 		}
 	}
 
+	if hasChange || d.HasChange("dns_record") {
+		err = resourceIbmSatelliteEndpointPublishDNSRecord(context, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIbmSatelliteEndpointRead(context, d, meta)
 }
 
@@ -1023,6 +1109,13 @@ func resourceIbmSatelliteEndpointDelete(context context.Context, d *schema.Resou
 	deleteEndpointsOptions.SetLocationID(parts[0])
 	deleteEndpointsOptions.SetEndpointID(parts[1])
 
+	if v, ok := d.GetOk("dns_record.0.record_id"); ok {
+		err = resourceIbmSatelliteEndpointDeleteDNSRecord(d.Get("dns_record.0.instance_id").(string), d.Get("dns_record.0.zone_id").(string), v.(string), meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, response, err := satelliteLinkClient.DeleteEndpointsWithContext(context, deleteEndpointsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteEndpointsWithContext failed %s\n%s", err, response)
@@ -1033,3 +1126,145 @@ func resourceIbmSatelliteEndpointDelete(context context.Context, d *schema.Resou
 
 	return nil
 }
+
+func resourceIbmSatelliteEndpointDNSRecordDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("dns_record.0.type").(string) == "SRV" &&
+		(diff.Get("dns_record.0.service").(string) == "" || diff.Get("dns_record.0.protocol").(string) == "") {
+		return fmt.Errorf("[ERROR] service and protocol of dns_record are required for an SRV record")
+	}
+	return nil
+}
+
+// resourceIbmSatelliteEndpointPublishDNSRecord creates, updates or deletes the DNS Services resource record
+// of the endpoint so that it points to the current client host and port of the endpoint
+func resourceIbmSatelliteEndpointPublishDNSRecord(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	o, n := d.GetChange("dns_record")
+	var oldRecord, newRecord map[string]interface{}
+	if l := o.([]interface{}); len(l) > 0 && l[0] != nil {
+		oldRecord = l[0].(map[string]interface{})
+	}
+	if l := n.([]interface{}); len(l) > 0 && l[0] != nil {
+		newRecord = l[0].(map[string]interface{})
+	}
+
+	recordID := ""
+	if oldRecord != nil && oldRecord["record_id"] != nil {
+		recordID = oldRecord["record_id"].(string)
+	}
+	// a record that moves to another instance, zone or type is replaced
+	if recordID != "" && (newRecord == nil || oldRecord["instance_id"] != newRecord["instance_id"] ||
+		oldRecord["zone_id"] != newRecord["zone_id"] || oldRecord["type"] != newRecord["type"]) {
+		err := resourceIbmSatelliteEndpointDeleteDNSRecord(oldRecord["instance_id"].(string), oldRecord["zone_id"].(string), recordID, meta)
+		if err != nil {
+			return err
+		}
+		recordID = ""
+	}
+	if newRecord == nil {
+		return nil
+	}
+
+	satelliteLinkClient, err := meta.(conns.ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return err
+	}
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return err
+	}
+	getEndpointsOptions := &satellitelinkv1.GetEndpointsOptions{}
+	getEndpointsOptions.SetLocationID(parts[0])
+	getEndpointsOptions.SetEndpointID(parts[1])
+	endpoint, response, err := satelliteLinkClient.GetEndpointsWithContext(context, getEndpointsOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the client host of endpoint %s: %s\n%s", parts[1], err, response)
+	}
+	if endpoint.ClientHost == nil || endpoint.ClientPort == nil {
+		return fmt.Errorf("[ERROR] Endpoint %s has no client host and port to publish", parts[1])
+	}
+
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	instanceID := newRecord["instance_id"].(string)
+	zoneID := newRecord["zone_id"].(string)
+	recordType := newRecord["type"].(string)
+	ttl := int64(newRecord["ttl"].(int))
+	service := newRecord["service"].(string)
+	protocol := newRecord["protocol"].(string)
+
+	mk := "private_dns_resource_record_" + instanceID + zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	if recordID == "" {
+		createResourceRecordOptions := sess.NewCreateResourceRecordOptions(instanceID, zoneID)
+		createResourceRecordOptions.SetName(newRecord["name"].(string))
+		createResourceRecordOptions.SetType(recordType)
+		createResourceRecordOptions.SetTTL(ttl)
+		if recordType == "SRV" {
+			rdata, err := sess.NewResourceRecordInputRdataRdataSrvRecord(*endpoint.ClientPort, 0, *endpoint.ClientHost, 0)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error creating SRV record data of endpoint %s: %s", parts[1], err)
+			}
+			createResourceRecordOptions.SetRdata(rdata)
+			createResourceRecordOptions.SetService(service)
+			createResourceRecordOptions.SetProtocol(protocol)
+		} else {
+			rdata, err := sess.NewResourceRecordInputRdataRdataCnameRecord(*endpoint.ClientHost)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error creating CNAME record data of endpoint %s: %s", parts[1], err)
+			}
+			createResourceRecordOptions.SetRdata(rdata)
+		}
+		record, detail, err := sess.CreateResourceRecordWithContext(context, createResourceRecordOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error publishing endpoint %s in DNS zone %s: %s\n%s", parts[1], zoneID, err, detail)
+		}
+		recordID = *record.ID
+	} else {
+		updateResourceRecordOptions := sess.NewUpdateResourceRecordOptions(instanceID, zoneID, recordID)
+		updateResourceRecordOptions.SetName(newRecord["name"].(string))
+		updateResourceRecordOptions.SetTTL(ttl)
+		if recordType == "SRV" {
+			rdata, err := sess.NewResourceRecordUpdateInputRdataRdataSrvRecord(*endpoint.ClientPort, 0, *endpoint.ClientHost, 0)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error creating SRV record data of endpoint %s: %s", parts[1], err)
+			}
+			updateResourceRecordOptions.SetRdata(rdata)
+			updateResourceRecordOptions.SetService(service)
+			updateResourceRecordOptions.SetProtocol(protocol)
+		} else {
+			rdata, err := sess.NewResourceRecordUpdateInputRdataRdataCnameRecord(*endpoint.ClientHost)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error creating CNAME record data of endpoint %s: %s", parts[1], err)
+			}
+			updateResourceRecordOptions.SetRdata(rdata)
+		}
+		_, detail, err := sess.UpdateResourceRecordWithContext(context, updateResourceRecordOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the DNS record of endpoint %s: %s\n%s", parts[1], err, detail)
+		}
+	}
+
+	newRecord["record_id"] = recordID
+	return d.Set("dns_record", []interface{}{newRecord})
+}
+
+func resourceIbmSatelliteEndpointDeleteDNSRecord(instanceID, zoneID, recordID string, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	mk := "private_dns_resource_record_" + instanceID + zoneID
+	conns.IbmMutexKV.Lock(mk)
+	defer conns.IbmMutexKV.Unlock(mk)
+
+	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(instanceID, zoneID, recordID)
+	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting the DNS record %s of the endpoint: %s\n%s", recordID, err, response)
+	}
+	return nil
+}
@@ -124,6 +124,38 @@ func TestAccIbmSatelliteEndpointAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSatelliteEndpointDNSRecord(t *testing.T) {
+	var conf satellitelinkv1.Endpoint
+	locationID := fmt.Sprintf("tf-location-%d", acctest.RandIntRange(10, 100))
+	displayName := fmt.Sprintf("tf-display-name-%d", acctest.RandIntRange(10, 100))
+	dnsInstanceName := fmt.Sprintf("tf-dns-instance-%d", acctest.RandIntRange(10, 100))
+	zoneName := fmt.Sprintf("tf-satellite-%d.com", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSatelliteEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSatelliteEndpointConfigDNSRecord(locationID, displayName, dnsInstanceName, zoneName, "CNAME", 900),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSatelliteEndpointExists("ibm_satellite_endpoint.satellite_endpoint", conf),
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint.satellite_endpoint", "dns_record.0.type", "CNAME"),
+					resource.TestCheckResourceAttrSet("ibm_satellite_endpoint.satellite_endpoint", "dns_record.0.record_id"),
+				),
+			},
+			{
+				Config: testAccCheckIbmSatelliteEndpointConfigDNSRecord(locationID, displayName, dnsInstanceName, zoneName, "SRV", 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint.satellite_endpoint", "dns_record.0.type", "SRV"),
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint.satellite_endpoint", "dns_record.0.ttl", "300"),
+					resource.TestCheckResourceAttrSet("ibm_satellite_endpoint.satellite_endpoint", "dns_record.0.record_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSatelliteEndpointConfigBasic(locationID string, connType string, displayName string, serverHost string, serverPort string, clientProtocol, serverProtocol string) string {
 	return fmt.Sprintf(`
 
@@ -235,3 +267,44 @@ func testAccCheckIbmSatelliteEndpointDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIbmSatelliteEndpointConfigDNSRecord(locationID, displayName, dnsInstanceName, zoneName, recordType string, ttl int) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "rg" {
+			is_default = true
+		}
+
+		resource "ibm_resource_instance" "dns_instance" {
+			name              = "%[3]s"
+			resource_group_id = data.ibm_resource_group.rg.id
+			location          = "global"
+			service           = "dns-svcs"
+			plan              = "standard-dns"
+		}
+
+		resource "ibm_dns_zone" "dns_zone" {
+			name        = "%[4]s"
+			instance_id = ibm_resource_instance.dns_instance.guid
+		}
+
+		resource "ibm_satellite_endpoint" "satellite_endpoint" {
+			location        = "%[1]s"
+			connection_type = "location"
+			display_name    = "%[2]s"
+			server_host     = "cloud.ibm.com"
+			server_port     = 443
+			client_protocol = "tcp"
+			server_protocol = "tcp"
+
+			dns_record {
+				instance_id = ibm_resource_instance.dns_instance.guid
+				zone_id     = ibm_dns_zone.dns_zone.zone_id
+				name        = "endpoint"
+				type        = "%[5]s"
+				ttl         = %[6]d
+				service     = "_endpoint"
+				protocol    = "tcp"
+			}
+		}
+	`, locationID, displayName, dnsInstanceName, zoneName, recordType, ttl)
+}
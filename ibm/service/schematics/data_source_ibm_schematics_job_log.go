@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+func DataSourceIBMSchematicsJobLog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMSchematicsJobLogRead,
+
+		Schema: map[string]*schema.Schema{
+			"job_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Job Id. Use `GET /v2/jobs` API to look up the Job Ids in your IBM Cloud account.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "List of locations supported by IBM Cloud Schematics service.  While creating your workspace or action, choose the right region, since it cannot be changed.  Note, this does not limit the location of the IBM Cloud resources, provisioned using Schematics.",
+			},
+			"job_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Job name, uniquely derived from the related Workspace, Action or Controls.",
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Format of the Log text.",
+			},
+			"details": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Log text, generated by the Job.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Job status updation timestamp.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMSchematicsJobLogRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if r, ok := d.GetOk("location"); ok {
+		region := r.(string)
+		schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+		if updatedURL {
+			schematicsClient.Service.Options.URL = schematicsURL
+		}
+	}
+	listJobLogsOptions := &schematicsv1.ListJobLogsOptions{}
+
+	listJobLogsOptions.SetJobID(d.Get("job_id").(string))
+
+	jobLog, response, err := schematicsClient.ListJobLogsWithContext(context, listJobLogsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListJobLogsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListJobLogsWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*listJobLogsOptions.JobID)
+	if err = d.Set("job_name", jobLog.JobName); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting job_name: %s", err))
+	}
+	if err = d.Set("format", jobLog.Format); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting format: %s", err))
+	}
+	if jobLog.Details != nil {
+		if err = d.Set("details", string(*jobLog.Details)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting details: %s", err))
+		}
+	}
+	if err = d.Set("updated_at", flex.DateTimeToString(jobLog.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsJobLogDataSourceBasic(t *testing.T) {
+	jobCommandParameter := fmt.Sprintf("command_parameter_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsJobLogDataSourceConfig(acc.ActionID, jobCommandParameter),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_job_log.schematics_job_log", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_job_log.schematics_job_log", "job_name"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_job_log.schematics_job_log", "format"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsJobLogDataSourceConfig(jobCommandObjectID, jobCommandParameter string) string {
+	return fmt.Sprintf(`
+		resource "ibm_schematics_job" "schematics_job" {
+			command_object = "action"
+			command_object_id = "%s"
+			command_name = "ansible_playbook_run"
+			command_parameter = "%s"
+			location = "us"
+		}
+
+		data "ibm_schematics_job_log" "schematics_job_log" {
+			job_id = ibm_schematics_job.schematics_job.id
+			location = "us"
+		}
+	`, jobCommandObjectID, jobCommandParameter)
+}
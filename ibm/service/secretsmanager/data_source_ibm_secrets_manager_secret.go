@@ -7,13 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
-	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
-	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -250,137 +248,68 @@ func dataSourceIBMSecretsManagerSecretRead(context context.Context, d *schema.Re
 	}
 	region := bluemixSession.Config.Region
 
-	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV1()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	// The v1 API is retired, serve the legacy attributes from the v2 API.
+	// Secret IDs are unchanged by the migration so v1 IDs are looked up as is.
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	instanceID := d.Get("instance_id").(string)
-	endpointType := d.Get("endpoint_type").(string)
-	var smEndpointURL string
-	resourceInstanceOptions := rc.GetResourceInstanceOptions{
-		ID: &instanceID,
-	}
-	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceOptions)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error:%s Response: %s", err, resp))
-	}
-	instanceCRN := instanceData.CRN
-
-	crnData := strings.Split(*instanceCRN, ":")
-
-	if crnData[4] == "secrets-manager" {
-		if endpointType == "private" {
-			smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
-		} else {
-			smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
-		}
-		smUrl := conns.EnvFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL)
-		secretsManagerClient.Service.Options.URL = smUrl
-	} else {
-		return diag.FromErr(fmt.Errorf("[ERROR] Invalid or unsupported service Instance"))
-	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceID, region, d.Get("endpoint_type").(string))
 
 	secretType := d.Get("secret_type").(string)
 	secretID := d.Get("secret_id").(string)
-	getSecretOptions := &secretsmanagerv1.GetSecretOptions{
-		SecretType: &secretType,
-		ID:         &secretID,
+	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+	getSecretOptions.SetID(secretID)
+
+	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] GetSecretWithContext failed %s\n%s", err, response))
 	}
 
-	getSecret, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+	secret, err := legacySecretToMap(secretIntf)
 	if err != nil {
-		log.Printf("[DEBUG] GetSecret failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading secret %s: %s", secretID, err))
+	}
+	if secret["secret_type"] != secretType {
+		return diag.FromErr(fmt.Errorf("[ERROR] Secret %s is of type %v, not %s", secretID, secret["secret_type"], secretType))
 	}
 
 	d.SetId(dataSourceIBMSecretsManagerSecretID(d))
 
-	if getSecret.Metadata != nil {
-		err = d.Set("metadata", dataSourceGetSecretFlattenMetadata(*getSecret.Metadata))
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error setting metadata %s", err))
-		}
+	err = d.Set("metadata", []map[string]interface{}{{
+		"collection_type":  legacySecretCollectionType,
+		"collection_total": 1,
+	}})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting metadata %s", err))
 	}
 
-	if getSecret.Resources != nil {
-		for _, resourcesItem := range getSecret.Resources {
-			if ritem, ok := resourcesItem.(*secretsmanagerv1.SecretResource); ok {
-				//if ritem.Type != nil {
-				//	d.Set("type", *ritem.Type)
-				//}
-				if ritem.Name != nil {
-					d.Set("name", *ritem.Name)
-				}
-				if ritem.Description != nil {
-					d.Set("description", *ritem.Description)
-				}
-				if ritem.SecretGroupID != nil {
-					d.Set("secret_group_id", *ritem.SecretGroupID)
-				}
-				if ritem.Labels != nil {
-					d.Set("labels", ritem.Labels)
-				}
-				if ritem.State != nil {
-					d.Set("state", *ritem.State)
-				}
-				if ritem.StateDescription != nil {
-					d.Set("state_description", *ritem.StateDescription)
-				}
-				if ritem.CRN != nil {
-					d.Set("crn", *ritem.CRN)
-				}
-				if ritem.CreationDate != nil {
-					d.Set("creation_date", (*ritem.CreationDate).String())
-				}
-				if ritem.CreatedBy != nil {
-					d.Set("created_by", *ritem.CreatedBy)
-				}
-				if ritem.LastUpdateDate != nil {
-					d.Set("last_update_date", (*ritem.LastUpdateDate).String())
-				}
-				if ritem.Versions != nil {
-					versionsList := []map[string]interface{}{}
-					for _, versionsItem := range ritem.Versions {
-						versionsList = append(versionsList, dataSourceGetSecretResourcesVersionsToMap(versionsItem))
-					}
-					d.Set("versions", versionsList)
-				}
-				if ritem.SecretData != nil {
-					secretData := ritem.SecretData
-					d.Set("secret_data", secretData)
-					if *ritem.SecretType == "username_password" {
-						d.Set("username", secretData["username"].(string))
-						d.Set("password", secretData["password"].(string))
-					} else if *ritem.SecretType == "arbitrary" {
-						d.Set("payload", secretData["payload"].(string))
-					}
-				}
-				if ritem.NextRotationDate != nil {
-					d.Set("next_rotation_date", (*ritem.NextRotationDate).String())
-				}
-				if ritem.TTL != nil {
-					d.Set("ttl", fmt.Sprintf("%v", ritem.TTL))
-				}
-				if ritem.AccessGroups != nil {
-					d.Set("access_groups", ritem.AccessGroups)
-				}
-				if ritem.APIKey != nil {
-					d.Set("api_key", *ritem.APIKey)
-				}
-				if ritem.ServiceID != nil {
-					d.Set("service_id", *ritem.ServiceID)
-				}
-				if ritem.ReuseAPIKey != nil {
-					d.Set("reuse_api_key", *ritem.ReuseAPIKey)
-				}
-			}
+	for key, value := range secret {
+		if key == "secret_id" || key == "secret_type" {
+			continue
+		}
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting %s %s", key, err))
 		}
 	}
 
+	listSecretVersionsOptions := &secretsmanagerv2.ListSecretVersionsOptions{}
+	listSecretVersionsOptions.SetSecretID(secretID)
+	versions, response, err := secretsManagerClient.ListSecretVersionsWithContext(context, listSecretVersionsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretVersionsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListSecretVersionsWithContext failed %s\n%s", err, response))
+	}
+	versionsList, err := legacySecretVersionsToList(versions.Versions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading versions of secret %s: %s", secretID, err))
+	}
+	if err = d.Set("versions", versionsList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting versions %s", err))
+	}
+
 	return nil
 }
 
@@ -388,43 +317,3 @@ func dataSourceIBMSecretsManagerSecretRead(context context.Context, d *schema.Re
 func dataSourceIBMSecretsManagerSecretID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
-
-func dataSourceGetSecretFlattenMetadata(result secretsmanagerv1.CollectionMetadata) (finalList []map[string]interface{}) {
-	finalList = []map[string]interface{}{}
-	finalMap := dataSourceGetSecretMetadataToMap(result)
-	finalList = append(finalList, finalMap)
-
-	return finalList
-}
-
-func dataSourceGetSecretMetadataToMap(metadataItem secretsmanagerv1.CollectionMetadata) (metadataMap map[string]interface{}) {
-	metadataMap = map[string]interface{}{}
-
-	if metadataItem.CollectionType != nil {
-		metadataMap["collection_type"] = metadataItem.CollectionType
-	}
-	if metadataItem.CollectionTotal != nil {
-		metadataMap["collection_total"] = metadataItem.CollectionTotal
-	}
-
-	return metadataMap
-}
-
-func dataSourceGetSecretResourcesVersionsToMap(versionsItem map[string]interface{}) (versionsMap map[string]interface{}) {
-	versionsMap = map[string]interface{}{}
-
-	if id, ok := versionsItem["id"]; ok {
-		versionsMap["id"] = id
-	}
-	if creation_date, ok := versionsItem["creation_date"]; ok {
-		versionsMap["creation_date"] = creation_date
-	}
-	if created_by, ok := versionsItem["created_by"]; ok {
-		versionsMap["created_by"] = created_by
-	}
-	if rotated, ok := versionsItem["auto_rotated"]; ok {
-		versionsMap["auto_rotated"] = rotated
-	}
-
-	return versionsMap
-}
@@ -25,6 +25,8 @@ func TestAccIBMSecretsManagerSecretDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_secrets_manager_secret.secrets_manager_secret", "secret_type"),
 					resource.TestCheckResourceAttrSet("data.ibm_secrets_manager_secret.secrets_manager_secret", "secret_id"),
 					resource.TestCheckResourceAttrSet("data.ibm_secrets_manager_secret.secrets_manager_secret", "metadata.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_secrets_manager_secret.secrets_manager_secret", "crn"),
+					resource.TestCheckResourceAttrSet("data.ibm_secrets_manager_secret.secrets_manager_secret", "versions.#"),
 				),
 			},
 		},
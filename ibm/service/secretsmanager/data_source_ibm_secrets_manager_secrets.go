@@ -7,12 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
-	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -263,70 +262,45 @@ func dataSourceIBMSecretsManagerSecretsRead(context context.Context, d *schema.R
 	}
 	region := bluemixSession.Config.Region
 
-	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV1()
+	// The v1 API is retired, serve the legacy attributes from the v2 API
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	rContollerClient, err := meta.(conns.ClientSession).ResourceControllerAPIV2()
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	instanceID := d.Get("instance_id").(string)
-	endpointType := d.Get("endpoint_type").(string)
-	var smEndpointURL string
-
-	rContollerAPI := rContollerClient.ResourceServiceInstanceV2()
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceID, region, d.Get("endpoint_type").(string))
 
-	instanceData, err := rContollerAPI.GetInstance(instanceID)
+	pager, err := secretsManagerClient.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	instanceCRN := instanceData.Crn.String()
-
-	crnData := strings.Split(instanceCRN, ":")
-
-	if crnData[4] == "secrets-manager" {
-		if endpointType == "private" {
-			smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
-		} else {
-			smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
-		}
-		smUrl := conns.EnvFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL)
-		secretsManagerClient.Service.Options.URL = smUrl
-	} else {
-		return diag.FromErr(fmt.Errorf("[ERROR] Invalid or unsupported service Instance"))
-	}
-
-	listAllSecretsOptions := &secretsmanagerv1.ListAllSecretsOptions{}
-
-	listSecrets, response, err := secretsManagerClient.ListAllSecretsWithContext(context, listAllSecretsOptions)
+	allItems, err := pager.GetAllWithContext(context)
 	if err != nil {
-		log.Printf("[DEBUG] ListAllSecretsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		log.Printf("[DEBUG] SecretsPager.GetAll() failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] SecretsPager.GetAll() failed %s", err))
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
-	var matchResources []secretsmanagerv1.SecretResourceIntf
 	var secretType string
 	var suppliedFilter bool
-
 	if v, ok := d.GetOk("secret_type"); ok {
 		secretType = v.(string)
 		suppliedFilter = true
-		for _, data := range listSecrets.Resources {
-			if rdata, ok := data.(*secretsmanagerv1.SecretResource); ok {
-				if *rdata.SecretType == secretType {
-					matchResources = append(matchResources, data)
-				}
-			}
+	}
+
+	secrets := []map[string]interface{}{}
+	for _, item := range allItems {
+		secret, err := legacySecretToMap(item)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error reading secrets %s", err))
 		}
-	} else {
-		matchResources = listSecrets.Resources
+		if suppliedFilter && secret["secret_type"] != secretType {
+			continue
+		}
+		secrets = append(secrets, secret)
 	}
-	listSecrets.Resources = matchResources
 
-	if len(listSecrets.Resources) == 0 {
+	if len(secrets) == 0 {
 		return diag.FromErr(fmt.Errorf("no Resources found with secretType %s\nIf not specified, please specify more filters", secretType))
 	}
 
@@ -336,18 +310,16 @@ func dataSourceIBMSecretsManagerSecretsRead(context context.Context, d *schema.R
 		d.SetId(dataSourceIBMSecretsManagerSecretsID(d))
 	}
 
-	if listSecrets.Metadata != nil {
-		err = d.Set("metadata", dataSourceListSecretsFlattenMetadata(*listSecrets.Metadata))
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error setting metadata %s", err))
-		}
+	err = d.Set("metadata", []map[string]interface{}{{
+		"collection_type":  legacySecretCollectionType,
+		"collection_total": len(secrets),
+	}})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting metadata %s", err))
 	}
 
-	if listSecrets.Resources != nil {
-		err = d.Set("secrets", dataSourceListSecretsFlattenResources(listSecrets.Resources))
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources %s", err))
-		}
+	if err = d.Set("secrets", secrets); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources %s", err))
 	}
 
 	return nil
@@ -357,141 +329,3 @@ func dataSourceIBMSecretsManagerSecretsRead(context context.Context, d *schema.R
 func dataSourceIBMSecretsManagerSecretsID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
-
-func dataSourceListSecretsFlattenMetadata(result secretsmanagerv1.CollectionMetadata) (finalList []map[string]interface{}) {
-	finalList = []map[string]interface{}{}
-	finalMap := dataSourceListSecretsMetadataToMap(result)
-	finalList = append(finalList, finalMap)
-
-	return finalList
-}
-
-func dataSourceListSecretsMetadataToMap(metadataItem secretsmanagerv1.CollectionMetadata) (metadataMap map[string]interface{}) {
-	metadataMap = map[string]interface{}{}
-
-	if metadataItem.CollectionType != nil {
-		metadataMap["collection_type"] = metadataItem.CollectionType
-	}
-	if metadataItem.CollectionTotal != nil {
-		metadataMap["collection_total"] = metadataItem.CollectionTotal
-	}
-
-	return metadataMap
-}
-
-func dataSourceListSecretsFlattenResources(result []secretsmanagerv1.SecretResourceIntf) (resources []map[string]interface{}) {
-	for _, resourcesItem := range result {
-		if ritem, ok := resourcesItem.(*secretsmanagerv1.SecretResource); ok {
-			resources = append(resources, dataSourceListSecretsResourcesToMap(*ritem))
-		}
-	}
-
-	return resources
-}
-
-func dataSourceListSecretsResourcesToMap(resourcesItem secretsmanagerv1.SecretResource) (resourcesMap map[string]interface{}) {
-	resourcesMap = map[string]interface{}{}
-
-	//if resourcesItem.Type != nil {
-	//	resourcesMap["type"] = *resourcesItem.Type
-	//}
-	if resourcesItem.ID != nil {
-		resourcesMap["secret_id"] = *resourcesItem.ID
-	}
-	if resourcesItem.Name != nil {
-		resourcesMap["name"] = *resourcesItem.Name
-	}
-	if resourcesItem.Description != nil {
-		resourcesMap["description"] = *resourcesItem.Description
-	}
-	if resourcesItem.SecretGroupID != nil {
-		resourcesMap["secret_group_id"] = *resourcesItem.SecretGroupID
-	}
-	if resourcesItem.Labels != nil {
-		resourcesMap["labels"] = resourcesItem.Labels
-	}
-	if resourcesItem.State != nil {
-		resourcesMap["state"] = *resourcesItem.State
-	}
-	if resourcesItem.StateDescription != nil {
-		resourcesMap["state_description"] = *resourcesItem.StateDescription
-	}
-	if resourcesItem.SecretType != nil {
-		resourcesMap["secret_type"] = *resourcesItem.SecretType
-	}
-	if resourcesItem.CRN != nil {
-		resourcesMap["crn"] = *resourcesItem.CRN
-	}
-	if resourcesItem.CreationDate != nil {
-		resourcesMap["creation_date"] = (*resourcesItem.CreationDate).String()
-	}
-	if resourcesItem.CreatedBy != nil {
-		resourcesMap["created_by"] = *resourcesItem.CreatedBy
-	}
-	if resourcesItem.LastUpdateDate != nil {
-		resourcesMap["last_update_date"] = (*resourcesItem.LastUpdateDate).String()
-	}
-	if resourcesItem.Versions != nil {
-		versionsList := []map[string]interface{}{}
-		for _, versionsItem := range resourcesItem.Versions {
-			versionsList = append(versionsList, dataSourceListSecretsResourcesVersionsToMap(versionsItem))
-		}
-		resourcesMap["versions"] = versionsList
-	}
-	if resourcesItem.ExpirationDate != nil {
-		resourcesMap["expiration_date"] = (*resourcesItem.ExpirationDate).String()
-	}
-	// Commented out because the response to list secrets shouldn't include payload
-	//if resourcesItem.Payload != nil {
-	//	resourcesMap["payload"] = *resourcesItem.Payload
-	//}
-	if resourcesItem.SecretData != nil {
-		secretData := resourcesItem.SecretData
-		resourcesMap["secret_data"] = secretData
-		if *resourcesItem.SecretType == "username_password" {
-			resourcesMap["username"] = secretData["username"].(string)
-			resourcesMap["password"] = secretData["password"].(string)
-		} else if *resourcesItem.SecretType == "arbitrary" {
-			resourcesMap["payload"] = secretData["payload"].(string)
-		}
-	}
-	if resourcesItem.NextRotationDate != nil {
-		resourcesMap["next_rotation_date"] = (*resourcesItem.NextRotationDate).String()
-	}
-	if resourcesItem.TTL != nil {
-		resourcesMap["ttl"] = fmt.Sprintf("%v", resourcesItem.TTL)
-	}
-	if resourcesItem.AccessGroups != nil {
-		resourcesMap["access_groups"] = resourcesItem.AccessGroups
-	}
-	if resourcesItem.APIKey != nil {
-		resourcesMap["api_key"] = *resourcesItem.APIKey
-	}
-	if resourcesItem.ServiceID != nil {
-		resourcesMap["service_id"] = *resourcesItem.ServiceID
-	}
-	if resourcesItem.ReuseAPIKey != nil {
-		resourcesMap["reuse_api_key"] = *resourcesItem.ReuseAPIKey
-	}
-
-	return resourcesMap
-}
-
-func dataSourceListSecretsResourcesVersionsToMap(versionsItem map[string]interface{}) (versionsMap map[string]interface{}) {
-	versionsMap = map[string]interface{}{}
-
-	if id, ok := versionsItem["id"]; ok {
-		versionsMap["id"] = id
-	}
-	if creation_date, ok := versionsItem["creation_date"]; ok {
-		versionsMap["creation_date"] = creation_date
-	}
-	if created_by, ok := versionsItem["created_by"]; ok {
-		versionsMap["created_by"] = created_by
-	}
-	if rotated, ok := versionsItem["auto_rotated"]; ok {
-		versionsMap["auto_rotated"] = rotated
-	}
-
-	return versionsMap
-}
@@ -129,7 +129,10 @@ func dataSourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resou
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
@@ -123,7 +123,10 @@ func dataSourceIbmSmArbitrarySecretMetadataRead(context context.Context, d *sche
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
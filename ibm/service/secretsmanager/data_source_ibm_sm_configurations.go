@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
@@ -36,6 +37,28 @@ func DataSourceIbmSmConfigurations() *schema.Resource {
 				Optional:    true,
 				Description: "Filter secrets by groups. You can apply multiple filters by using a comma-separated list of secret group IDs. If you need to filter secrets that are in the default secret group, use the `default` keyword.",
 			},
+			"config_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{
+					secretsmanagerv2.ConfigurationMetadata_ConfigType_IamCredentialsConfiguration,
+					secretsmanagerv2.ConfigurationMetadata_ConfigType_PrivateCertConfigurationRootCa,
+					secretsmanagerv2.ConfigurationMetadata_ConfigType_PrivateCertConfigurationIntermediateCa,
+					secretsmanagerv2.ConfigurationMetadata_ConfigType_PrivateCertConfigurationTemplate,
+					secretsmanagerv2.ConfigurationMetadata_ConfigType_PublicCertConfigurationCaLetsEncrypt,
+					secretsmanagerv2.ConfigurationMetadata_ConfigType_PublicCertConfigurationDnsCloudInternetServices,
+					secretsmanagerv2.ConfigurationMetadata_ConfigType_PublicCertConfigurationDnsClassicInfrastructure}),
+				Description: "Filter configurations by configuration type.",
+			},
+			"secret_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{
+					secretsmanagerv2.ConfigurationMetadata_SecretType_IamCredentials,
+					secretsmanagerv2.ConfigurationMetadata_SecretType_PrivateCert,
+					secretsmanagerv2.ConfigurationMetadata_SecretType_PublicCert}),
+				Description: "Filter configurations by the secret type of their secrets engine. Supported values are iam_credentials, private_cert and public_cert.",
+			},
 			"total_count": &schema.Schema{
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -91,7 +114,10 @@ func dataSourceIbmSmConfigurationsRead(context context.Context, d *schema.Resour
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	listConfigurationsOptions := &secretsmanagerv2.ListConfigurationsOptions{}
@@ -120,12 +146,20 @@ func dataSourceIbmSmConfigurationsRead(context context.Context, d *schema.Resour
 
 	d.SetId(dataSourceIbmSmConfigurationsID(d))
 
+	configType := d.Get("config_type").(string)
+	secretType := d.Get("secret_type").(string)
 	mapSlice := []map[string]interface{}{}
 	for _, modelItem := range allItems {
 		modelMap, err := dataSourceIbmSmConfigurationsConfigurationMetadataToMap(modelItem)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		if configType != "" && modelMap["config_type"] != configType {
+			continue
+		}
+		if secretType != "" && modelMap["secret_type"] != secretType {
+			continue
+		}
 		mapSlice = append(mapSlice, modelMap)
 	}
 
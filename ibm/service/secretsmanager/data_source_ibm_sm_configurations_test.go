@@ -27,6 +27,23 @@ func TestAccIbmSmConfigurationsDataSourceBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmConfigurationsDataSourceTypeFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmConfigurationsDataSourceConfigTypeFilter(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_sm_configurations.sm_configurations", "configurations.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_sm_configurations.sm_configurations", "configurations.0.config_type", "iam_credentials_configuration"),
+					resource.TestCheckResourceAttr("data.ibm_sm_configurations.sm_configurations", "total_count", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSmConfigurationsDataSourceConfigBasic() string {
 	return fmt.Sprintf(`
 		resource "ibm_sm_iam_credentials_configuration" "sm_iam_credentials_configuration_instance" {
@@ -83,3 +100,21 @@ func testAccCheckIbmSmConfigurationsDataSourceConfigBasic() string {
 		acc.SecretsManagerPublicCertificateClassicInfrastructureUsername, acc.SecretsManagerPublicCertificateClassicInfrastructurePassword,
 		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
 }
+
+func testAccCheckIbmSmConfigurationsDataSourceConfigTypeFilter() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_iam_credentials_configuration" "sm_iam_credentials_configuration_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "terraform-test-datasource-iam-configuration"
+			api_key = "%s"
+		}
+		data "ibm_sm_configurations" "sm_configurations" {
+			instance_id   = "%s"
+			region        = "%s"
+			secret_type   = "iam_credentials"
+			depends_on    = [ibm_sm_iam_credentials_configuration.sm_iam_credentials_configuration_instance]
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerIamCredentialsConfigurationApiKey,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
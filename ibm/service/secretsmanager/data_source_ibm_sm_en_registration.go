@@ -35,7 +35,10 @@ func dataSourceIbmSmEnRegistrationRead(context context.Context, d *schema.Resour
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	getNotificationsRegistrationOptions := &secretsmanagerv2.GetNotificationsRegistrationOptions{}
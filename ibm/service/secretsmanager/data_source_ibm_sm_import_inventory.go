@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+// smImportInventoryResourceTypes maps secret types to the resources that manage them.
+// Secrets of other types have no resource in the provider and are not listed.
+var smImportInventoryResourceTypes = map[string]string{
+	"arbitrary":         "ibm_sm_arbitrary_secret",
+	"iam_credentials":   "ibm_sm_iam_credentials_secret",
+	"imported_cert":     "ibm_sm_imported_certificate",
+	"kv":                "ibm_sm_kv_secret",
+	"private_cert":      "ibm_sm_private_certificate",
+	"public_cert":       "ibm_sm_public_certificate",
+	"username_password": "ibm_sm_username_password_secret",
+}
+
+func DataSourceIbmSmImportInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmImportInventoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"resources": flex.ImportInventorySchema(),
+		},
+	}
+}
+
+func dataSourceIbmSmImportInventoryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	inventory := &flex.ImportInventory{}
+
+	secretGroupCollection, response, err := secretsManagerClient.ListSecretGroupsWithContext(context, &secretsmanagerv2.ListSecretGroupsOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretGroupsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListSecretGroupsWithContext failed %s\n%s", err, response))
+	}
+	for _, secretGroup := range secretGroupCollection.SecretGroups {
+		// The default secret group exists in every instance and cannot be managed.
+		if *secretGroup.ID == "default" {
+			continue
+		}
+		inventory.Add("ibm_sm_secret_group", *secretGroup.Name, fmt.Sprintf("%s/%s/%s", region, instanceId, *secretGroup.ID))
+	}
+
+	pager, err := secretsManagerClient.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allItems, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] SecretsPager.GetAll() failed %s", err)
+		return diag.FromErr(fmt.Errorf("SecretsPager.GetAll() failed %s", err))
+	}
+	for _, secret := range allItems {
+		// The metadata models differ per secret type, the common fields are read from their JSON form.
+		raw, err := json.Marshal(secret)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		metadata := struct {
+			ID         string `json:"id"`
+			Name       string `json:"name"`
+			SecretType string `json:"secret_type"`
+		}{}
+		if err = json.Unmarshal(raw, &metadata); err != nil {
+			return diag.FromErr(err)
+		}
+		resourceType, ok := smImportInventoryResourceTypes[metadata.SecretType]
+		if !ok {
+			continue
+		}
+		inventory.Add(resourceType, metadata.Name, fmt.Sprintf("%s/%s/%s", region, instanceId, metadata.ID))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", region, instanceId))
+
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("resources", inventory.Resources()); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resources %s", err))
+	}
+
+	return nil
+}
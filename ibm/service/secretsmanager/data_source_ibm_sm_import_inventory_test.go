@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmImportInventoryDataSourceBasic(t *testing.T) {
+	secretGroupName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmImportInventoryDataSourceConfigBasic(secretGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_sm_import_inventory.sm_import_inventory", "instance_id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.ibm_sm_import_inventory.sm_import_inventory", "resources.*", map[string]string{
+						"type":    "ibm_sm_secret_group",
+						"name":    secretGroupName,
+						"address": "ibm_sm_secret_group." + secretGroupName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmImportInventoryDataSourceConfigBasic(secretGroupName string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_secret_group" "sm_secret_group_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "%s"
+		}
+
+		data "ibm_sm_import_inventory" "sm_import_inventory" {
+			depends_on = [
+				ibm_sm_secret_group.sm_secret_group_instance
+			]
+			instance_id   = "%s"
+			region        = "%s"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, secretGroupName, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -260,7 +260,10 @@ func dataSourceIbmSmPrivateCertificateConfigurationIntermediateCARead(context co
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
@@ -57,7 +57,10 @@ func dataSourceIbmSmSecretGroupRead(context context.Context, d *schema.ResourceD
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	getSecretGroupOptions := &secretsmanagerv2.GetSecretGroupOptions{}
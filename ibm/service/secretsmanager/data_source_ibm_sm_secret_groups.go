@@ -72,7 +72,10 @@ func dataSourceIbmSmSecretGroupsRead(context context.Context, d *schema.Resource
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	listSecretGroupsOptions := &secretsmanagerv2.ListSecretGroupsOptions{}
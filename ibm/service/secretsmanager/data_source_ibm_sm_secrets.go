@@ -394,7 +394,10 @@ func dataSourceIbmSmSecretsRead(context context.Context, d *schema.ResourceData,
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	listSecretsOptions := &secretsmanagerv2.ListSecretsOptions{}
@@ -147,7 +147,10 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
@@ -168,7 +171,7 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmArbitrarySecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmArbitrarySecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmArbitrarySecret (%s) to be created: %s", d.Id(), err))
@@ -177,7 +180,7 @@ func resourceIbmSmArbitrarySecretCreate(context context.Context, d *schema.Resou
 	return resourceIbmSmArbitrarySecretRead(context, d, meta)
 }
 
-func waitForIbmSmArbitrarySecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmArbitrarySecretCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 	id := strings.Split(d.Id(), "/")
 	secretId := id[2]
@@ -188,7 +191,7 @@ func waitForIbmSmArbitrarySecretCreate(secretsManagerClient *secretsmanagerv2.Se
 		Pending: []string{"pre_activation"},
 		Target:  []string{"active"},
 		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+			stateObjIntf, response, err := secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
 			stateObj := stateObjIntf.(*secretsmanagerv2.ArbitrarySecret)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
@@ -207,7 +210,7 @@ func waitForIbmSmArbitrarySecretCreate(secretsManagerClient *secretsmanagerv2.Se
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -40,6 +40,26 @@ func TestAccIbmSmArbitrarySecretBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmArbitrarySecretProviderInstance(t *testing.T) {
+	var conf secretsmanagerv2.ArbitrarySecret
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmArbitrarySecretConfigProviderInstance(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmArbitrarySecretExists("ibm_sm_arbitrary_secret.sm_arbitrary_secret", conf),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "instance_id", acc.SecretsManagerInstanceID),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "region", acc.SecretsManagerInstanceRegion),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSmArbitrarySecretConfigBasic() string {
 	return fmt.Sprintf(`
 		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
@@ -55,6 +75,23 @@ func testAccCheckIbmSmArbitrarySecretConfigBasic() string {
 	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
 }
 
+func testAccCheckIbmSmArbitrarySecretConfigProviderInstance() string {
+	return fmt.Sprintf(`
+		provider "ibm" {
+			sm_instance {
+				instance_id = "%s"
+				region      = "%s"
+			}
+		}
+
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			name = "terraform-test-arbitrary-secret-provider-instance"
+			payload = "secret-credentials"
+			secret_group_id = "default"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
+
 func testAccCheckIbmSmArbitrarySecretExists(n string, obj secretsmanagerv2.ArbitrarySecret) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
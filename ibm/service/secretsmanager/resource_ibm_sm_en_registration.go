@@ -14,6 +14,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
@@ -44,6 +45,16 @@ func ResourceIbmSmEnRegistration() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_sm_en_registration", "event_notifications_source_description"),
 				Description:  "An optional description for the source  that is in your Event Notifications instance.",
 			},
+			"event_notifications_source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the source that is in your Event Notifications instance.",
+			},
+			"event_notifications_source_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the source that is in your Event Notifications instance is enabled.",
+			},
 		},
 	}
 }
@@ -91,7 +102,10 @@ func resourceIbmSmEnRegistrationCreate(context context.Context, d *schema.Resour
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createNotificationsRegistrationOptions := &secretsmanagerv2.CreateNotificationsRegistrationOptions{}
@@ -146,9 +160,99 @@ func resourceIbmSmEnRegistrationRead(context context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("Error setting event_notifications_instance_crn: %s", err))
 	}
 
+	// The registration only returns the Event Notifications instance, the source is read
+	// from Event Notifications to detect changes of its name and description.
+	source, err := getSmEnRegistrationSource(context, meta, *notificationsRegistration.EventNotificationsInstanceCrn, instanceId, d)
+	if err != nil {
+		log.Printf("[WARN] Unable to read the Event Notifications source of Secrets Manager instance %s: %s", instanceId, err)
+		return nil
+	}
+	if source == nil {
+		log.Printf("[WARN] No Event Notifications source found for Secrets Manager instance %s", instanceId)
+		if err = d.Set("event_notifications_source_id", ""); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting event_notifications_source_id: %s", err))
+		}
+		return nil
+	}
+	if err = d.Set("event_notifications_source_id", source.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting event_notifications_source_id: %s", err))
+	}
+	if err = d.Set("event_notifications_source_name", source.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting event_notifications_source_name: %s", err))
+	}
+	if err = d.Set("event_notifications_source_description", source.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting event_notifications_source_description: %s", err))
+	}
+	if err = d.Set("event_notifications_source_enabled", source.Enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting event_notifications_source_enabled: %s", err))
+	}
+
 	return nil
 }
 
+// getSmEnRegistrationSource returns the source of the Secrets Manager instance in the Event
+// Notifications instance. The source ID is taken from the state when known, otherwise the
+// source whose ID contains the Secrets Manager instance ID, or else the source with the
+// configured name, is used.
+func getSmEnRegistrationSource(context context.Context, meta interface{}, enInstanceCrn, instanceId string, d *schema.ResourceData) (*en.SourceListItem, error) {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return nil, err
+	}
+	enInstanceId := enInstanceCrn
+	if crnSegments := strings.Split(enInstanceCrn, ":"); len(crnSegments) > 7 {
+		enInstanceId = crnSegments[7]
+	}
+
+	if sourceId, ok := d.GetOk("event_notifications_source_id"); ok {
+		getSourceOptions := &en.GetSourceOptions{}
+		getSourceOptions.SetInstanceID(enInstanceId)
+		getSourceOptions.SetID(sourceId.(string))
+		source, response, err := enClient.GetSourceWithContext(context, getSourceOptions)
+		if err == nil {
+			return &en.SourceListItem{
+				ID:          source.ID,
+				Name:        source.Name,
+				Description: source.Description,
+				Enabled:     source.Enabled,
+			}, nil
+		}
+		if response == nil || response.StatusCode != 404 {
+			return nil, fmt.Errorf("GetSourceWithContext failed %s\n%s", err, response)
+		}
+	}
+
+	var byName *en.SourceListItem
+	configuredName := d.Get("event_notifications_source_name").(string)
+	var offset int64 = 0
+	var limit int64 = 100
+	for {
+		listSourcesOptions := &en.ListSourcesOptions{}
+		listSourcesOptions.SetInstanceID(enInstanceId)
+		listSourcesOptions.SetLimit(limit)
+		listSourcesOptions.SetOffset(offset)
+		sourceList, response, err := enClient.ListSourcesWithContext(context, listSourcesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("ListSourcesWithContext failed %s\n%s", err, response)
+		}
+		for i := range sourceList.Sources {
+			source := sourceList.Sources[i]
+			if source.ID != nil && strings.Contains(*source.ID, instanceId) {
+				return &source, nil
+			}
+			if byName == nil && source.Name != nil && *source.Name == configuredName {
+				byName = &source
+			}
+		}
+		offset += limit
+		if len(sourceList.Sources) == 0 || sourceList.TotalCount == nil || offset >= *sourceList.TotalCount {
+			break
+		}
+	}
+
+	return byName, nil
+}
+
 func resourceIbmSmEnRegistrationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
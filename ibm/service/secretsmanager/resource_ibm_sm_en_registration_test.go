@@ -27,6 +27,9 @@ func TestAccIbmSmEnRegistrationBasic(t *testing.T) {
 				Config: testAccCheckIbmSmEnRegistrationConfigBasic(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIbmSmEnRegistrationExists("ibm_sm_en_registration.sm_en_registration", conf),
+					resource.TestCheckResourceAttrSet("ibm_sm_en_registration.sm_en_registration", "event_notifications_source_id"),
+					resource.TestCheckResourceAttr("ibm_sm_en_registration.sm_en_registration", "event_notifications_source_name", "My Secrets Manager Terraform Test"),
+					resource.TestCheckResourceAttr("ibm_sm_en_registration.sm_en_registration", "event_notifications_source_enabled", "true"),
 				),
 			},
 		},
@@ -75,7 +75,10 @@ func resourceIbmSmIamCredentialsConfigurationCreate(context context.Context, d *
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createConfigurationOptions := &secretsmanagerv2.CreateConfigurationOptions{}
@@ -214,7 +214,10 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
@@ -235,7 +238,7 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmIamCredentialsSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmIamCredentialsSecret (%s) to be created: %s", d.Id(), err))
@@ -244,7 +247,7 @@ func resourceIbmSmIamCredentialsSecretCreate(context context.Context, d *schema.
 	return resourceIbmSmIamCredentialsSecretRead(context, d, meta)
 }
 
-func waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmIamCredentialsSecretCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -256,7 +259,7 @@ func waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient *secretsmanager
 		Pending: []string{"pre_activation"},
 		Target:  []string{"active"},
 		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+			stateObjIntf, response, err := secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
 			stateObj := stateObjIntf.(*secretsmanagerv2.IAMCredentialsSecret)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
@@ -275,7 +278,7 @@ func waitForIbmSmIamCredentialsSecretCreate(secretsManagerClient *secretsmanager
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIbmSmIamCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -233,7 +233,10 @@ func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.R
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
@@ -254,7 +257,7 @@ func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.R
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmImportedCertificateCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmImportedCertificateCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmImportedCertificate (%s) to be created: %s", d.Id(), err))
@@ -263,7 +266,7 @@ func resourceIbmSmImportedCertificateCreate(context context.Context, d *schema.R
 	return resourceIbmSmImportedCertificateRead(context, d, meta)
 }
 
-func waitForIbmSmImportedCertificateCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmImportedCertificateCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -275,7 +278,7 @@ func waitForIbmSmImportedCertificateCreate(secretsManagerClient *secretsmanagerv
 		Pending: []string{"pre_activation"},
 		Target:  []string{"active"},
 		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+			stateObjIntf, response, err := secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
 			stateObj := stateObjIntf.(*secretsmanagerv2.ImportedCertificate)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
@@ -294,7 +297,7 @@ func waitForIbmSmImportedCertificateCreate(secretsManagerClient *secretsmanagerv
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIbmSmImportedCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -139,7 +139,10 @@ func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
@@ -160,7 +163,7 @@ func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmKvSecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmKvSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmKvSecret (%s) to be created: %s", d.Id(), err))
@@ -169,7 +172,7 @@ func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData
 	return resourceIbmSmKvSecretRead(context, d, meta)
 }
 
-func waitForIbmSmKvSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmKvSecretCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -181,7 +184,7 @@ func waitForIbmSmKvSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsMa
 		Pending: []string{"pre_activation"},
 		Target:  []string{"active"},
 		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+			stateObjIntf, response, err := secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
 			stateObj := stateObjIntf.(*secretsmanagerv2.KVSecret)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
@@ -200,7 +203,7 @@ func waitForIbmSmKvSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsMa
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -321,7 +321,10 @@ func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.Re
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
@@ -342,7 +345,7 @@ func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.Re
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmPrivateCertificateCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmPrivateCertificateCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmPrivateCertificate (%s) to be created: %s", d.Id(), err))
@@ -351,7 +354,7 @@ func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.Re
 	return resourceIbmSmPrivateCertificateRead(context, d, meta)
 }
 
-func waitForIbmSmPrivateCertificateCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmPrivateCertificateCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -363,7 +366,7 @@ func waitForIbmSmPrivateCertificateCreate(secretsManagerClient *secretsmanagerv2
 		Pending: []string{"pre_activation"},
 		Target:  []string{"active"},
 		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+			stateObjIntf, response, err := secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
 			stateObj := stateObjIntf.(*secretsmanagerv2.PrivateCertificate)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
@@ -382,7 +385,7 @@ func waitForIbmSmPrivateCertificateCreate(secretsManagerClient *secretsmanagerv2
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -345,7 +345,10 @@ func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.Res
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
@@ -366,7 +369,7 @@ func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.Res
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmPublicCertificateCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmPublicCertificateCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmPublicCertificate (%s) to be created: %s", d.Id(), err))
@@ -375,7 +378,7 @@ func resourceIbmSmPublicCertificateCreate(context context.Context, d *schema.Res
 	return resourceIbmSmPublicCertificateRead(context, d, meta)
 }
 
-func waitForIbmSmPublicCertificateCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmPublicCertificateCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 
 	id := strings.Split(d.Id(), "/")
@@ -383,22 +386,30 @@ func waitForIbmSmPublicCertificateCreate(secretsManagerClient *secretsmanagerv2.
 
 	getSecretOptions.SetID(secretId)
 
+	// With a manual DNS provider the certificate stays in pre_activation until the challenges are
+	// validated, which is done by ibm_sm_public_certificate_action_validate_manual_dns. Creation
+	// is done once the challenges to publish are known.
+	manualDns := d.Get("dns").(string) == "manual"
+
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pre_activation"},
-		Target:  []string{"active"},
+		Target:  []string{"active", "challenges_ready"},
 		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
-			stateObj := stateObjIntf.(*secretsmanagerv2.PublicCertificate)
+			stateObjIntf, response, err := secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("The instance %s does not exist anymore: %s\n%s", "getSecretOptions", err, response)
 				}
 				return nil, "", err
 			}
+			stateObj := stateObjIntf.(*secretsmanagerv2.PublicCertificate)
 			failStates := map[string]bool{"destroyed": true}
 			if failStates[*stateObj.StateDescription] {
 				return stateObj, *stateObj.StateDescription, fmt.Errorf("The instance %s failed: %s\n%s", "getSecretOptions", err, response)
 			}
+			if manualDns && *stateObj.StateDescription == "pre_activation" && stateObj.IssuanceInfo != nil && len(stateObj.IssuanceInfo.Challenges) > 0 {
+				return stateObj, "challenges_ready", nil
+			}
 			return stateObj, *stateObj.StateDescription, nil
 		},
 		Timeout:    d.Timeout(schema.TimeoutCreate),
@@ -406,7 +417,7 @@ func waitForIbmSmPublicCertificateCreate(secretsManagerClient *secretsmanagerv2.
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIbmSmPublicCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
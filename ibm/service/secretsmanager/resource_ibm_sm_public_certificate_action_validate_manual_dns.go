@@ -0,0 +1,286 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
+)
+
+func ResourceIbmSmPublicCertificateActionValidateManualDns() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmPublicCertificateActionValidateManualDnsCreate,
+		ReadContext:   resourceIbmSmPublicCertificateActionValidateManualDnsRead,
+		UpdateContext: resourceIbmSmPublicCertificateActionValidateManualDnsUpdate,
+		DeleteContext: resourceIbmSmPublicCertificateActionValidateManualDnsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the public certificate ordered with a manual DNS provider.",
+			},
+			"wait_for_dns_propagation": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to wait until every TXT record of the challenges resolves to its value before asking for the validation.",
+			},
+			"challenges": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The challenges of the certificate order, as they were validated.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The challenge domain.",
+						},
+						"expiration": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The challenge expiration date. The date format follows RFC 3339.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The challenge status.",
+						},
+						"txt_record_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The TXT record name.",
+						},
+						"txt_record_value": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The TXT record value.",
+						},
+					},
+				},
+			},
+			"state_description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the certificate after the validation.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmPublicCertificateActionValidateManualDnsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	certificate, err := getIbmSmPublicCertificateMetadata(context, secretsManagerClient, secretId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if certificate.IssuanceInfo == nil || len(certificate.IssuanceInfo.Challenges) == 0 {
+		return diag.FromErr(fmt.Errorf("The public certificate %s has no DNS challenges to validate, it must be ordered with a manual DNS provider and be in pre_activation", secretId))
+	}
+	challenges := certificate.IssuanceInfo.Challenges
+
+	if d.Get("wait_for_dns_propagation").(bool) {
+		if err = waitForIbmSmPublicCertificateDnsPropagation(context, challenges, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error waiting for the DNS challenges of public certificate %s to propagate: %s", secretId, err))
+		}
+	}
+
+	secretActionPrototype, err := secretsManagerClient.NewPublicCertificateActionValidateManualDNSPrototype(secretsmanagerv2.PublicCertificateActionValidateManualDNSPrototype_ActionType_PublicCertActionValidateDnsChallenge)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	createSecretActionOptions := secretsManagerClient.NewCreateSecretActionOptions(secretId, secretActionPrototype)
+
+	_, response, err := secretsManagerClient.CreateSecretActionWithContext(context, createSecretActionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretActionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSecretActionWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+
+	challengesList := []map[string]interface{}{}
+	for _, challenge := range challenges {
+		challengeMap, err := resourceIbmSmPublicCertificateChallengeResourceToMap(&challenge)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		challengesList = append(challengesList, challengeMap)
+	}
+	if err = d.Set("challenges", challengesList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting challenges: %s", err))
+	}
+
+	if _, err = waitForIbmSmPublicCertificateValidated(context, secretsManagerClient, secretId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error waiting for public certificate %s to be validated: %s", secretId, err))
+	}
+
+	return resourceIbmSmPublicCertificateActionValidateManualDnsRead(context, d, meta)
+}
+
+func getIbmSmPublicCertificateMetadata(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string) (*secretsmanagerv2.PublicCertificateMetadata, error) {
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response)
+	}
+	certificate, ok := secretMetadataIntf.(*secretsmanagerv2.PublicCertificateMetadata)
+	if !ok {
+		return nil, fmt.Errorf("The secret %s is not a public certificate", secretId)
+	}
+	return certificate, nil
+}
+
+// waitForIbmSmPublicCertificateDnsPropagation waits until every challenge TXT record resolves to its value
+func waitForIbmSmPublicCertificateDnsPropagation(ctx context.Context, challenges []secretsmanagerv2.ChallengeResource, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"propagating"},
+		Target:  []string{"propagated"},
+		Refresh: func() (interface{}, string, error) {
+			for _, challenge := range challenges {
+				if challenge.TxtRecordName == nil || challenge.TxtRecordValue == nil {
+					continue
+				}
+				name := strings.TrimSuffix(*challenge.TxtRecordName, ".")
+				records, err := net.DefaultResolver.LookupTXT(ctx, name)
+				if err != nil {
+					log.Printf("[DEBUG] TXT record %s does not resolve yet: %s", name, err)
+					return challenges, "propagating", nil
+				}
+				found := false
+				for _, record := range records {
+					if record == *challenge.TxtRecordValue {
+						found = true
+						break
+					}
+				}
+				if !found {
+					log.Printf("[DEBUG] TXT record %s does not have the challenge value yet", name)
+					return challenges, "propagating", nil
+				}
+			}
+			return challenges, "propagated", nil
+		},
+		Timeout:    timeout,
+		Delay:      0 * time.Second,
+		MinTimeout: 15 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func waitForIbmSmPublicCertificateValidated(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pre_activation"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			certificate, err := getIbmSmPublicCertificateMetadata(ctx, secretsManagerClient, secretId)
+			if err != nil {
+				return nil, "", err
+			}
+			if certificate.IssuanceInfo != nil && certificate.IssuanceInfo.ErrorMessage != nil && *certificate.IssuanceInfo.ErrorMessage != "" {
+				return certificate, "", fmt.Errorf("The validation of public certificate %s failed: %s", secretId, *certificate.IssuanceInfo.ErrorMessage)
+			}
+			return certificate, *certificate.StateDescription, nil
+		},
+		Timeout:    timeout,
+		Delay:      0 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func resourceIbmSmPublicCertificateActionValidateManualDnsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 3 {
+		return diag.FromErr(fmt.Errorf("Wrong format of resource ID. To import a secret use the format `<region>/<instance_id>/<secret_id>`"))
+	}
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+	}
+	certificate, ok := secretMetadataIntf.(*secretsmanagerv2.PublicCertificateMetadata)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("The secret %s is not a public certificate", secretId))
+	}
+
+	if err = d.Set("secret_id", secretId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("state_description", certificate.StateDescription); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting state_description: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmPublicCertificateActionValidateManualDnsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only endpoint_type can change in place, it affects how the instance is reached and nothing else.
+	return resourceIbmSmPublicCertificateActionValidateManualDnsRead(context, d, meta)
+}
+
+func resourceIbmSmPublicCertificateActionValidateManualDnsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The validation cannot be undone, deleting the resource only removes it from the state.
+	d.SetId("")
+	return nil
+}
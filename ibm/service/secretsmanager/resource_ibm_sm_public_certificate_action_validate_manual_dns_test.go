@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+// The TXT records of the challenges must be published for the common name by the test
+// environment, the step waits for them to propagate before asking for the validation.
+func TestAccIbmSmPublicCertificateActionValidateManualDnsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPublicCertificateActionValidateManualDnsConfigBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_public_certificate.sm_public_certificate", "state_description", "pre_activation"),
+					resource.TestCheckResourceAttrSet("ibm_sm_public_certificate.sm_public_certificate", "issuance_info.0.challenges.0.txt_record_name"),
+					resource.TestCheckResourceAttrSet("ibm_sm_public_certificate_action_validate_manual_dns.sm_public_certificate_action_validate_manual_dns", "challenges.0.txt_record_value"),
+					resource.TestCheckResourceAttr("ibm_sm_public_certificate_action_validate_manual_dns.sm_public_certificate_action_validate_manual_dns", "state_description", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmPublicCertificateActionValidateManualDnsConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_public_certificate_configuration_ca_lets_encrypt" "sm_public_certificate_configuration_ca_lets_encrypt_instance" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "public_cert_ca_lets_encrypt-terraform-test-manual-dns"
+			lets_encrypt_environment = "%s"
+			lets_encrypt_private_key = "%s"
+		}
+
+		resource "ibm_sm_public_certificate" "sm_public_certificate" {
+			instance_id = "%s"
+			region = "%s"
+			name = "public-certificate-manual-dns-terraform-tests"
+			secret_group_id = "default"
+			common_name = "%s"
+			ca = ibm_sm_public_certificate_configuration_ca_lets_encrypt.sm_public_certificate_configuration_ca_lets_encrypt_instance.name
+			dns = "manual"
+		}
+
+		resource "ibm_sm_public_certificate_action_validate_manual_dns" "sm_public_certificate_action_validate_manual_dns" {
+			instance_id = "%s"
+			region = "%s"
+			secret_id = ibm_sm_public_certificate.sm_public_certificate.secret_id
+			wait_for_dns_propagation = true
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerPublicCertificateLetsEncryptEnvironment, acc.SecretsManagerPublicCertificateLetsEncryptPrivateKey,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerPublicCertificateCommonName,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -69,7 +69,10 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptCreate(context cont
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createConfigurationOptions := &secretsmanagerv2.CreateConfigurationOptions{}
@@ -109,7 +109,10 @@ func resourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructureCreate(c
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 	bodyModelMap := map[string]interface{}{}
 	createConfigurationOptions := &secretsmanagerv2.CreateConfigurationOptions{}
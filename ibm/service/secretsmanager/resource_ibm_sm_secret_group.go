@@ -44,6 +44,12 @@ func ResourceIbmSmSecretGroup() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_sm_secret_group", "description"),
 				Description:  "An extended description of your secret group.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
 			},
+			"force_delete": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the secrets in the secret group when the secret group is deleted. If false, deleting a secret group that contains secrets fails and lists them.",
+			},
 			"created_at": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -92,7 +98,10 @@ func resourceIbmSmSecretGroupCreate(context context.Context, d *schema.ResourceD
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createSecretGroupOptions := &secretsmanagerv2.CreateSecretGroupOptions{}
@@ -155,6 +164,9 @@ func resourceIbmSmSecretGroupRead(context context.Context, d *schema.ResourceDat
 	if err = d.Set("description", secretGroup.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
 	}
+	if err = d.Set("force_delete", d.Get("force_delete").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting force_delete: %s", err))
+	}
 	if err = d.Set("created_at", flex.DateTimeToString(secretGroup.CreatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
 	}
@@ -219,6 +231,29 @@ func resourceIbmSmSecretGroupDelete(context context.Context, d *schema.ResourceD
 	secretGroupId := id[2]
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
+	secrets, err := listSmSecretGroupSecrets(secretsManagerClient, secretGroupId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(secrets) > 0 {
+		if !d.Get("force_delete").(bool) {
+			names := make([]string, 0, len(secrets))
+			for _, secret := range secrets {
+				names = append(names, fmt.Sprintf("%s (%s)", secret["name"], secret["id"]))
+			}
+			return diag.FromErr(fmt.Errorf("Secret group %s contains %d secret(s): %s. Delete or move them first, or set force_delete to true to delete them with the secret group", secretGroupId, len(secrets), strings.Join(names, ", ")))
+		}
+		for _, secret := range secrets {
+			deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
+			deleteSecretOptions.SetID(secret["id"].(string))
+			response, err := secretsManagerClient.DeleteSecretWithContext(context, deleteSecretOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed for secret %s in secret group %s: %s\n%s", secret["id"], secretGroupId, err, response))
+			}
+		}
+	}
+
 	deleteSecretGroupOptions := &secretsmanagerv2.DeleteSecretGroupOptions{}
 
 	deleteSecretGroupOptions.SetID(secretGroupId)
@@ -233,3 +268,28 @@ func resourceIbmSmSecretGroupDelete(context context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+// listSmSecretGroupSecrets returns the id and name of each secret in the secret group.
+func listSmSecretGroupSecrets(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretGroupId string) ([]map[string]interface{}, error) {
+	pager, err := secretsManagerClient.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	allItems, err := pager.GetAll()
+	if err != nil {
+		log.Printf("[DEBUG] SecretsPager.GetAll() failed %s", err)
+		return nil, fmt.Errorf("SecretsPager.GetAll() failed %s", err)
+	}
+
+	secrets := []map[string]interface{}{}
+	for _, item := range allItems {
+		modelMap, err := dataSourceIbmSmSecretsSecretMetadataToMap(item)
+		if err != nil {
+			return nil, err
+		}
+		if modelMap["secret_group_id"] == secretGroupId {
+			secrets = append(secrets, modelMap)
+		}
+	}
+	return secrets, nil
+}
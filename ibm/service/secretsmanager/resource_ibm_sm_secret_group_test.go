@@ -14,6 +14,7 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 )
 
@@ -80,6 +81,28 @@ func TestAccIbmSmSecretGroupAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmSecretGroupForceDelete(t *testing.T) {
+	var conf secretsmanagerv2.SecretGroup
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmSecretGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretGroupConfigForceDelete(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmSecretGroupExists("ibm_sm_secret_group.sm_secret_group", conf),
+					resource.TestCheckResourceAttr("ibm_sm_secret_group.sm_secret_group", "force_delete", "true"),
+					// A secret that is not managed by Terraform must be deleted with the secret group
+					testAccCheckIbmSmSecretGroupAddSecret("ibm_sm_secret_group.sm_secret_group"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmSmSecretGroupConfigBasic(name string) string {
 	return fmt.Sprintf(`
 
@@ -103,6 +126,49 @@ func testAccCheckIbmSmSecretGroupConfig(name string, description string) string
 	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, name, description)
 }
 
+func testAccCheckIbmSmSecretGroupConfigForceDelete(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_secret_group" "sm_secret_group" {
+			instance_id   = "%s"
+			region        = "%s"
+			name = "%s"
+			force_delete = true
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, name)
+}
+
+func testAccCheckIbmSmSecretGroupAddSecret(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		secretsManagerClient = getClientWithInstanceEndpointTest(secretsManagerClient)
+
+		id := strings.Split(rs.Primary.ID, "/")
+		secretGroupId := id[2]
+		createSecretOptions := &secretsmanagerv2.CreateSecretOptions{
+			SecretPrototype: &secretsmanagerv2.ArbitrarySecretPrototype{
+				Name:          core.StringPtr(fmt.Sprintf("%s-secret", rs.Primary.Attributes["name"])),
+				SecretType:    core.StringPtr("arbitrary"),
+				Payload:       core.StringPtr("secret-credentials"),
+				SecretGroupID: &secretGroupId,
+			},
+		}
+
+		_, _, err = secretsManagerClient.CreateSecret(createSecretOptions)
+		return err
+	}
+}
+
 func testAccCheckIbmSmSecretGroupExists(n string, obj secretsmanagerv2.SecretGroup) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
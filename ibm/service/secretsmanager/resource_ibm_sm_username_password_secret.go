@@ -185,7 +185,10 @@ func resourceIbmSmUsernamePasswordSecretCreate(context context.Context, d *schem
 	}
 
 	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
+	instanceId, err := getInstanceId(secretsManagerClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
 
 	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
@@ -205,7 +208,7 @@ func resourceIbmSmUsernamePasswordSecretCreate(context context.Context, d *schem
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, *secret.ID))
 	d.Set("secret_id", *secret.ID)
 
-	_, err = waitForIbmSmUsernamePasswordSecretCreate(secretsManagerClient, d)
+	_, err = waitForIbmSmUsernamePasswordSecretCreate(context, secretsManagerClient, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(
 			"Error waiting for resource IbmSmUsernamePasswordSecret (%s) to be created: %s", d.Id(), err))
@@ -214,7 +217,7 @@ func resourceIbmSmUsernamePasswordSecretCreate(context context.Context, d *schem
 	return resourceIbmSmUsernamePasswordSecretRead(context, d, meta)
 }
 
-func waitForIbmSmUsernamePasswordSecretCreate(secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
+func waitForIbmSmUsernamePasswordSecretCreate(ctx context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (interface{}, error) {
 	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
 	id := strings.Split(d.Id(), "/")
 	secretId := id[2]
@@ -224,7 +227,7 @@ func waitForIbmSmUsernamePasswordSecretCreate(secretsManagerClient *secretsmanag
 		Pending: []string{"pre_activation"},
 		Target:  []string{"active"},
 		Refresh: func() (interface{}, string, error) {
-			stateObjIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+			stateObjIntf, response, err := secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
 			stateObj := stateObjIntf.(*secretsmanagerv2.UsernamePasswordSecret)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
@@ -243,7 +246,7 @@ func waitForIbmSmUsernamePasswordSecretCreate(secretsManagerClient *secretsmanag
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIbmSmUsernamePasswordSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
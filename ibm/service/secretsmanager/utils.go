@@ -1,6 +1,7 @@
 package secretsmanager
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,6 +10,9 @@ import (
 	"strings"
 )
 
+// legacySecretCollectionType is the collection type reported by the v1 API for secret lists
+const legacySecretCollectionType = "application/vnd.ibm.secrets-manager.secret+json"
+
 func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
 	_, ok := d.GetOk("region")
 	if ok {
@@ -38,6 +42,21 @@ func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schem
 	}
 }
 
+// Get the instance ID from the schema, or from the host of the base URL when the provider
+// is configured with an instance endpoint like "https://<instance_id>.<region>.secrets-manager.<domain>"
+func getInstanceId(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("instance_id"); ok {
+		return v.(string), nil
+	}
+	baseUrl := originalClient.Service.GetServiceURL()
+	host := strings.TrimPrefix(strings.TrimPrefix(baseUrl, "https://"), "http://")
+	label := strings.Split(host, ".")[0]
+	if label != "" && label != "private" && label != "secrets-manager" {
+		return label, nil
+	}
+	return "", fmt.Errorf("instance_id is not set and the Secrets Manager endpoint of the provider (%s) is not an instance endpoint", baseUrl)
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManagerV2, instanceId string, region string, endpointType string) *secretsmanagerv2.SecretsManagerV2 {
 	// build the api endpoint
@@ -60,13 +79,29 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	return newClient
 }
 
-// Add the fields needed for building the instance endpoint to the given schema
+// GetClientForSecretCRN parses a secret CRN like "crn:v1:bluemix:public:secrets-manager:<region>:a/<account>:<instance_id>:secret:<secret_id>"
+// and returns a client for the instance that holds the secret, along with the secret ID
+func GetClientForSecretCRN(originalClient *secretsmanagerv2.SecretsManagerV2, secretCRN string) (*secretsmanagerv2.SecretsManagerV2, string, error) {
+	parts := strings.Split(secretCRN, ":")
+	if len(parts) != 10 || parts[4] != "secrets-manager" || parts[8] != "secret" || parts[5] == "" || parts[7] == "" || parts[9] == "" {
+		return nil, "", fmt.Errorf("%s is not a Secrets Manager secret CRN", secretCRN)
+	}
+	endpointType := "public"
+	if strings.Contains(originalClient.Service.GetServiceURL(), "private.") {
+		endpointType = "private"
+	}
+	return getClientWithInstanceEndpoint(originalClient, parts[7], parts[5], endpointType), parts[9], nil
+}
+
+// Add the fields needed for building the instance endpoint to the given schema. The instance
+// defaults to the sm_instance block of the provider, or to the instance of its Secrets Manager endpoint
 func AddInstanceFields(resource *schema.Resource) *schema.Resource {
 	resource.Schema["instance_id"] = &schema.Schema{
 		Type:        schema.TypeString,
-		Required:    true,
+		Optional:    true,
+		Computed:    true,
 		ForceNew:    true,
-		Description: "The ID of the Secrets Manager instance.",
+		Description: "The ID of the Secrets Manager instance. Defaults to the instance configured in the provider.",
 	}
 	resource.Schema["region"] = &schema.Schema{
 		Type:        schema.TypeString,
@@ -107,3 +142,110 @@ func StringIsIntBetween(min, max int) schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+// legacySecretToMap converts a v2 secret or secret metadata model into the attributes of the
+// v1 secret resource served by the ibm_secrets_manager_secret(s) data sources
+func legacySecretToMap(secret interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(secret)
+	if err != nil {
+		return nil, err
+	}
+	v2 := map[string]interface{}{}
+	if err = json.Unmarshal(raw, &v2); err != nil {
+		return nil, err
+	}
+
+	// v1 attribute name -> v2 field name
+	renamed := map[string]string{
+		"secret_id":          "id",
+		"name":               "name",
+		"description":        "description",
+		"secret_group_id":    "secret_group_id",
+		"labels":             "labels",
+		"state_description":  "state_description",
+		"secret_type":        "secret_type",
+		"crn":                "crn",
+		"creation_date":      "created_at",
+		"created_by":         "created_by",
+		"last_update_date":   "updated_at",
+		"expiration_date":    "expiration_date",
+		"next_rotation_date": "next_rotation_date",
+		"ttl":                "ttl",
+		"access_groups":      "access_groups",
+		"api_key":            "api_key",
+		"service_id":         "service_id",
+		"reuse_api_key":      "reuse_api_key",
+		"payload":            "payload",
+		"username":           "username",
+		"password":           "password",
+	}
+	legacy := map[string]interface{}{}
+	for v1Key, v2Key := range renamed {
+		if value, ok := v2[v2Key]; ok && value != nil {
+			legacy[v1Key] = value
+		}
+	}
+	if state, ok := v2["state"].(float64); ok {
+		legacy["state"] = int(state)
+	}
+
+	secretData := map[string]interface{}{}
+	switch v2["secret_type"] {
+	case "arbitrary":
+		if payload, ok := v2["payload"]; ok {
+			secretData["payload"] = payload
+		}
+	case "username_password":
+		for _, key := range []string{"username", "password"} {
+			if value, ok := v2[key]; ok {
+				secretData[key] = value
+			}
+		}
+	case "kv":
+		if data, ok := v2["data"].(map[string]interface{}); ok {
+			for key, value := range data {
+				if str, ok := value.(string); ok {
+					secretData[key] = str
+				} else {
+					encoded, _ := json.Marshal(value)
+					secretData[key] = string(encoded)
+				}
+			}
+		}
+	}
+	if len(secretData) > 0 {
+		legacy["secret_data"] = secretData
+	}
+
+	return legacy, nil
+}
+
+// legacySecretVersionsToList converts v2 secret version metadata into the v1 versions attribute
+func legacySecretVersionsToList(versions []secretsmanagerv2.SecretVersionMetadataIntf) ([]map[string]interface{}, error) {
+	versionsList := []map[string]interface{}{}
+	for _, version := range versions {
+		raw, err := json.Marshal(version)
+		if err != nil {
+			return nil, err
+		}
+		v2 := map[string]interface{}{}
+		if err = json.Unmarshal(raw, &v2); err != nil {
+			return nil, err
+		}
+		versionMap := map[string]interface{}{}
+		if id, ok := v2["id"]; ok {
+			versionMap["id"] = id
+		}
+		if createdAt, ok := v2["created_at"]; ok {
+			versionMap["creation_date"] = createdAt
+		}
+		if createdBy, ok := v2["created_by"]; ok {
+			versionMap["created_by"] = createdBy
+		}
+		if autoRotated, ok := v2["auto_rotated"]; ok {
+			versionMap["auto_rotated"] = autoRotated
+		}
+		versionsList = append(versionsList, versionMap)
+	}
+	return versionsList, nil
+}
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsBackupPolicySnapshot() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsBackupPolicySnapshotRead,
+
+		Schema: map[string]*schema.Schema{
+			"backup_policy_plan_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"backup_policy_plan_id", isSnapshotSourceVolume},
+				Description:  "Selects a snapshot created by the backup policy plan with this identifier.",
+			},
+			isSnapshotSourceVolume: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"backup_policy_plan_id", isSnapshotSourceVolume},
+				Description:  "Selects a snapshot of the volume with this identifier that was created by a backup policy.",
+			},
+			"captured_before": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Selects the latest snapshot captured before this time, in RFC 3339 format. Defaults to the latest snapshot.",
+			},
+			isSnapshotName: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the snapshot.",
+			},
+			isSnapshotCRN: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the snapshot.",
+			},
+			isSnapshotHref: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the snapshot.",
+			},
+			isSnapshotCapturedAt: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the snapshot was captured.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the snapshot was created.",
+			},
+			isSnapshotLCState: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the snapshot.",
+			},
+			isSnapshotBootable: &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if a boot volume attachment can be created with a volume created from this snapshot.",
+			},
+			isSnapshotMinCapacity: &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum capacity of a volume created from this snapshot, in gigabytes.",
+			},
+			isSnapshotSize: &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the snapshot, in gigabytes.",
+			},
+			isSnapshotOperatingSystem: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the operating system included in the snapshot.",
+			},
+			isSnapshotResourceGroup: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource group of the snapshot.",
+			},
+			"source_volume_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the volume the snapshot was created from.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsBackupPolicySnapshotRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var capturedBefore time.Time
+	if v, ok := d.GetOk("captured_before"); ok {
+		capturedBefore, err = time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Invalid captured_before %s: %s", v.(string), err))
+		}
+	}
+
+	var snapshot *vpcv1.Snapshot
+	start := ""
+	for snapshot == nil {
+		listSnapshotsOptions := &vpcv1.ListSnapshotsOptions{}
+		listSnapshotsOptions.SetSort("-created_at")
+		if start != "" {
+			listSnapshotsOptions.SetStart(start)
+		}
+		if v, ok := d.GetOk("backup_policy_plan_id"); ok {
+			listSnapshotsOptions.SetBackupPolicyPlanID(v.(string))
+		}
+		if v, ok := d.GetOk(isSnapshotSourceVolume); ok {
+			listSnapshotsOptions.SetSourceVolumeID(v.(string))
+		}
+
+		snapshots, response, err := vpcClient.ListSnapshotsWithContext(context, listSnapshotsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListSnapshotsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListSnapshotsWithContext failed %s\n%s", err, response))
+		}
+		for i := range snapshots.Snapshots {
+			if backupPolicySnapshotSelectable(snapshots.Snapshots[i], capturedBefore) {
+				snapshot = &snapshots.Snapshots[i]
+				break
+			}
+		}
+		start = flex.GetNext(snapshots.Next)
+		if start == "" {
+			break
+		}
+	}
+	if snapshot == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] No stable snapshot created by a backup policy matches the selection"))
+	}
+
+	d.SetId(*snapshot.ID)
+	d.Set(isSnapshotName, snapshot.Name)
+	d.Set(isSnapshotCRN, snapshot.CRN)
+	d.Set(isSnapshotHref, snapshot.Href)
+	d.Set(isSnapshotCapturedAt, flex.DateTimeToString(snapshot.CapturedAt))
+	d.Set("created_at", flex.DateTimeToString(snapshot.CreatedAt))
+	d.Set(isSnapshotLCState, snapshot.LifecycleState)
+	d.Set(isSnapshotBootable, snapshot.Bootable)
+	d.Set(isSnapshotMinCapacity, flex.IntValue(snapshot.MinimumCapacity))
+	d.Set(isSnapshotSize, flex.IntValue(snapshot.Size))
+	if snapshot.OperatingSystem != nil {
+		d.Set(isSnapshotOperatingSystem, snapshot.OperatingSystem.Name)
+	}
+	if snapshot.ResourceGroup != nil {
+		d.Set(isSnapshotResourceGroup, snapshot.ResourceGroup.ID)
+	}
+	if snapshot.BackupPolicyPlan != nil {
+		d.Set("backup_policy_plan_id", snapshot.BackupPolicyPlan.ID)
+	}
+	if snapshot.SourceVolume != nil {
+		d.Set(isSnapshotSourceVolume, snapshot.SourceVolume.ID)
+		d.Set("source_volume_name", snapshot.SourceVolume.Name)
+	}
+	return nil
+}
+
+// backupPolicySnapshotSelectable reports whether the snapshot was created by a backup policy, can be
+// restored from and, when capturedBefore is set, was captured before that time
+func backupPolicySnapshotSelectable(snapshot vpcv1.Snapshot, capturedBefore time.Time) bool {
+	if snapshot.BackupPolicyPlan == nil || snapshot.LifecycleState == nil || *snapshot.LifecycleState != isSnapshotAvailable {
+		return false
+	}
+	if capturedBefore.IsZero() {
+		return true
+	}
+	capturedAt := snapshot.CapturedAt
+	if capturedAt == nil {
+		capturedAt = snapshot.CreatedAt
+	}
+	return capturedAt != nil && time.Time(*capturedAt).Before(capturedBefore)
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsBackupPolicySnapshotDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsBackupPolicySnapshotDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_backup_policy_snapshot.restore_point", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_backup_policy_snapshot.restore_point", "backup_policy_plan_id"),
+					resource.TestCheckResourceAttr("data.ibm_is_backup_policy_snapshot.restore_point", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_backup_policy_snapshot.restore_point", "source_volume", "data.ibm_is_backup_policy_job.is_backup_policy_job", "source_volume.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsBackupPolicySnapshotDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_is_backup_policy_job" "is_backup_policy_job" {
+			backup_policy_id = "%s"
+			identifier       = "%s"
+		}
+
+		data "ibm_is_backup_policy_snapshot" "restore_point" {
+			source_volume   = data.ibm_is_backup_policy_job.is_backup_policy_job.source_volume.0.id
+			captured_before = timeadd(timestamp(), "-1m")
+		}`, acc.BackupPolicyID, acc.BackupPolicyJobID)
+}
@@ -27,6 +27,8 @@ func DataSourceIBMIsBareMetalServers() *schema.Resource {
 		ReadContext: dataSourceIBMISBareMetalServersRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -434,6 +436,7 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 	allrecs := []vpcv1.BareMetalServer{}
 
 	listBareMetalServersOptions := &vpcv1.ListBareMetalServersOptions{}
+	listBareMetalServersOptions.Limit = flex.GetPageSize(d)
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
 		listBareMetalServersOptions.ResourceGroupID = &resGroup
@@ -466,6 +469,7 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 		subnetCrn := subnetCrnIntf.(string)
 		listBareMetalServersOptions.NetworkInterfacesSubnetCRN = &subnetCrn
 	}
+	maxItems := flex.GetMaxItems(d)
 	for {
 
 		if start != "" {
@@ -477,10 +481,11 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 		}
 		start = flex.GetNext(availableServers.Next)
 		allrecs = append(allrecs, availableServers.BareMetalServers...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 
 	serversInfo := make([]map[string]interface{}, 0)
 	for _, bms := range allrecs {
@@ -28,6 +28,7 @@ const (
 	isVPEResourceType                     = "resource_type"
 	isVPEResourceFullQualifiedDomainNames = "full_qualified_domain_names"
 	isVPEResourceServiceLocation          = "location"
+	isVPERegion                           = "region"
 )
 
 func DataSourceIBMISEndpointGatewayTargets() *schema.Resource {
@@ -35,6 +36,17 @@ func DataSourceIBMISEndpointGatewayTargets() *schema.Resource {
 		ReadContext: dataSourceIBMISEndpointGatewayTargetsRead,
 
 		Schema: map[string]*schema.Schema{
+			isVPERegion: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region of the provider cloud services to list, defaults to the provider region",
+			},
+			isVPEResourceType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list targets of this resource type, for example provider_cloud_service",
+			},
 			isVPEResources: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -90,6 +102,10 @@ func dataSourceIBMISEndpointGatewayTargetsRead(context context.Context, d *schem
 		return diag.FromErr(err)
 	}
 	region := bmxSess.Config.Region
+	if r, ok := d.GetOk(isVPERegion); ok {
+		region = r.(string)
+	}
+	resourceType := d.Get(isVPEResourceType).(string)
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
 		return diag.FromErr(err)
@@ -188,6 +204,17 @@ func dataSourceIBMISEndpointGatewayTargetsRead(context context.Context, d *schem
 
 	}
 
+	if resourceType != "" {
+		filtered := make([]map[string]interface{}, 0, len(resourceInfo))
+		for _, info := range resourceInfo {
+			if info[isVPEResourceType] == resourceType {
+				filtered = append(filtered, info)
+			}
+		}
+		resourceInfo = filtered
+	}
+
+	d.Set(isVPERegion, region)
 	d.Set(isVPEResources, resourceInfo)
 	d.SetId(dataSourceIBMISEndpointGatewayTargetsId(d))
 	return nil
@@ -37,3 +37,29 @@ func testAccCheckIBMISEgtsDataSourceConfig() string {
 	  }
 	  `)
 }
+
+func TestAccIBMISEndpointGatewayTargetsDataSource_filter(t *testing.T) {
+	resName := "data.ibm_is_endpoint_gateway_targets.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISEgtsDataSourceFilterConfig("eu-de"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "region", "eu-de"),
+					resource.TestCheckResourceAttr(resName, "resources.0.resource_type", "provider_cloud_service"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISEgtsDataSourceFilterConfig(region string) string {
+	return fmt.Sprintf(`
+	data "ibm_is_endpoint_gateway_targets" "test" {
+		region        = "%s"
+		resource_type = "provider_cloud_service"
+	}
+	`, region)
+}
@@ -22,6 +22,8 @@ func DataSourceIBMISFlowLogs() *schema.Resource {
 		Read: dataSourceIBMISFlowLogsRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -149,6 +151,7 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 	start := ""
 	allrecs := []vpcv1.FlowLogCollector{}
 	listOptions := &vpcv1.ListFlowLogCollectorsOptions{}
+	listOptions.Limit = flex.GetPageSize(d)
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
 		listOptions.ResourceGroupID = &resGroup
@@ -177,6 +180,7 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		targetType := targetTypeIntf.(string)
 		listOptions.TargetResourceType = &targetType
 	}
+	maxItems := flex.GetMaxItems(d)
 	for {
 
 		if start != "" {
@@ -188,10 +192,11 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		}
 		start = flex.GetNext(flowlogCollectors.Next)
 		allrecs = append(allrecs, flowlogCollectors.FlowLogCollectors...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 	flowlogsInfo := make([]map[string]interface{}, 0)
 	for _, flowlogCollector := range allrecs {
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -145,6 +146,12 @@ func DataSourceIBMIsIkePolicy() *schema.Resource {
 				Computed:    true,
 				Description: "The resource type.",
 			},
+			"vpn_gateway_connections": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The VPN gateway connections that use this IKE policy, with their VPN gateway and status.",
+				Elem:        dataSourceIBMIsPolicyVPNGatewayConnectionsSchema(),
+			},
 		},
 	}
 }
@@ -248,6 +255,17 @@ func dataSourceIBMIsIkePolicyRead(context context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
 	}
 
+	listIkePolicyConnectionsOptions := &vpcv1.ListIkePolicyConnectionsOptions{}
+	listIkePolicyConnectionsOptions.SetID(*ikePolicy.ID)
+	ikePolicyConnections, response, err := vpcClient.ListIkePolicyConnectionsWithContext(context, listIkePolicyConnectionsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListIkePolicyConnectionsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListIkePolicyConnectionsWithContext failed %s\n%s", err, response))
+	}
+	if err = d.Set("vpn_gateway_connections", dataSourceIBMIsPolicyFlattenVPNGatewayConnections(ikePolicyConnections.Connections)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting vpn_gateway_connections: %s", err))
+	}
+
 	return nil
 }
 
@@ -317,3 +335,83 @@ func dataSourceIkePolicyResourceGroupToMap(resourceGroupItem vpcv1.ResourceGroup
 
 	return resourceGroupMap
 }
+
+// dataSourceIBMIsPolicyVPNGatewayConnectionsSchema is the schema of the VPN gateway connections that use an IKE or IPsec policy
+func dataSourceIBMIsPolicyVPNGatewayConnectionsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"admin_state_up": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "If set to false, the VPN gateway connection is shut down.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VPN connection's canonical URL.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for this VPN gateway connection.",
+			},
+			"mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mode of the VPN gateway.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user-defined name for this VPN connection.",
+			},
+			"peer_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address of the peer VPN gateway.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of a VPN gateway connection.",
+			},
+			"vpn_gateway": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the VPN gateway of this connection.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsPolicyFlattenVPNGatewayConnections(result []vpcv1.VPNGatewayConnectionIntf) (connections []map[string]interface{}) {
+	connections = []map[string]interface{}{}
+	for _, connectionIntf := range result {
+		connection, ok := connectionIntf.(*vpcv1.VPNGatewayConnection)
+		if !ok || connection == nil {
+			continue
+		}
+		connectionMap := map[string]interface{}{
+			"admin_state_up": connection.AdminStateUp,
+			"href":           connection.Href,
+			"id":             connection.ID,
+			"mode":           connection.Mode,
+			"name":           connection.Name,
+			"peer_address":   connection.PeerAddress,
+			"status":         connection.Status,
+		}
+		// the href is .../vpn_gateways/{vpn_gateway_id}/connections/{id}
+		if connection.Href != nil {
+			parts := strings.Split(*connection.Href, "/")
+			for i := 0; i+1 < len(parts); i++ {
+				if parts[i] == "vpn_gateways" {
+					connectionMap["vpn_gateway"] = parts[i+1]
+					break
+				}
+			}
+		}
+		connections = append(connections, connectionMap)
+	}
+
+	return connections
+}
@@ -33,6 +33,7 @@ func TestAccIBMIsIkePolicyDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_is_ike_policy.is_ike_policy", "negotiation_mode"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ike_policy.is_ike_policy", "resource_group.#"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ike_policy.is_ike_policy", "resource_type"),
+					resource.TestCheckResourceAttr("data.ibm_is_ike_policy.is_ike_policy", "vpn_gateway_connections.#", "0"),
 				),
 			},
 			{
@@ -50,6 +51,7 @@ func TestAccIBMIsIkePolicyDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_is_ike_policy.is_ike_policy1", "negotiation_mode"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ike_policy.is_ike_policy1", "resource_group.#"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ike_policy.is_ike_policy1", "resource_type"),
+					resource.TestCheckResourceAttr("data.ibm_is_ike_policy.is_ike_policy1", "vpn_gateway_connections.#", "0"),
 				),
 			},
 		},
@@ -25,6 +25,8 @@ func DataSourceIBMISImages() *schema.Resource {
 		Read: dataSourceIBMISImagesRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			isImagesResourceGroupID: {
 				Type:        schema.TypeString,
 				Description: "The id of the resource group",
@@ -235,7 +237,11 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 	if visibility != "" {
 		listImagesOptions.SetVisibility(visibility)
 	}
+	listImagesOptions.Limit = flex.GetPageSize(d)
 
+	// status and catalog_managed are filtered client side, so all pages are needed before max_items applies
+	maxItems := flex.GetMaxItems(d)
+	stopEarly := status == "" && !catalogManaged
 	for {
 		if start != "" {
 			listImagesOptions.Start = &start
@@ -246,7 +252,7 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		}
 		start = flex.GetNext(availableImages.Next)
 		allrecs = append(allrecs, availableImages.Images...)
-		if start == "" {
+		if start == "" || (stopEarly && flex.MaxItemsReached(maxItems, len(allrecs))) {
 			break
 		}
 	}
@@ -271,6 +277,8 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		allrecs = allrecsTemp
 	}
 
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
+
 	imagesInfo := make([]map[string]interface{}, 0)
 	for _, image := range allrecs {
 
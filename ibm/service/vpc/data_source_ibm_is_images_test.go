@@ -30,6 +30,24 @@ func TestAccIBMISImagesDataSource_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISImagesDataSource_maxItems(t *testing.T) {
+	resName := "data.ibm_is_images.test1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImagesDataSourceMaxItemsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "images.#", "3"),
+					resource.TestCheckResourceAttrSet(resName, "images.0.name"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISImagesDataSource_catalog(t *testing.T) {
 	resName := "data.ibm_is_images.test1"
 
@@ -115,3 +133,12 @@ func testAccCheckIBMISImagesDataSourceWithStatusPublic(status string) string {
 	}
 	`, status)
 }
+
+func testAccCheckIBMISImagesDataSourceMaxItemsConfig() string {
+	return `
+	data "ibm_is_images" "test1" {
+		visibility = "public"
+		max_items  = 3
+		page_size  = 2
+	}`
+}
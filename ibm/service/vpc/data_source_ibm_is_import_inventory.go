@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIsImportInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsImportInventoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the resources of this resource group",
+			},
+			"resources": flex.ImportInventorySchema(),
+		},
+	}
+}
+
+func dataSourceIBMIsImportInventoryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resourceGroup *string
+	if rg, ok := d.GetOk("resource_group"); ok {
+		resourceGroup = flex.PtrToString(rg.(string))
+	}
+
+	inventory := &flex.ImportInventory{}
+	// The default security group and network ACL of a VPC are managed through
+	// ibm_is_vpc, importing them as standalone resources would make destroy fail.
+	defaults := map[string]bool{}
+
+	start := ""
+	for {
+		listVpcsOptions := &vpcv1.ListVpcsOptions{ResourceGroupID: resourceGroup}
+		if start != "" {
+			listVpcsOptions.Start = &start
+		}
+		vpcs, response, err := sess.ListVpcsWithContext(context, listVpcsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListVpcsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing VPCs: %s\n%s", err, response))
+		}
+		for _, vpc := range vpcs.Vpcs {
+			inventory.Add("ibm_is_vpc", *vpc.Name, *vpc.ID)
+			if vpc.DefaultSecurityGroup != nil {
+				defaults[*vpc.DefaultSecurityGroup.ID] = true
+			}
+			if vpc.DefaultNetworkACL != nil {
+				defaults[*vpc.DefaultNetworkACL.ID] = true
+			}
+		}
+		start = flex.GetNext(vpcs.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{ResourceGroupID: resourceGroup}
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnets, response, err := sess.ListSubnetsWithContext(context, listSubnetsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListSubnetsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing subnets: %s\n%s", err, response))
+		}
+		for _, subnet := range subnets.Subnets {
+			inventory.Add("ibm_is_subnet", *subnet.Name, *subnet.ID)
+		}
+		start = flex.GetNext(subnets.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	for {
+		listPublicGatewaysOptions := &vpcv1.ListPublicGatewaysOptions{ResourceGroupID: resourceGroup}
+		if start != "" {
+			listPublicGatewaysOptions.Start = &start
+		}
+		publicGateways, response, err := sess.ListPublicGatewaysWithContext(context, listPublicGatewaysOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListPublicGatewaysWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing public gateways: %s\n%s", err, response))
+		}
+		for _, publicGateway := range publicGateways.PublicGateways {
+			inventory.Add("ibm_is_public_gateway", *publicGateway.Name, *publicGateway.ID)
+		}
+		start = flex.GetNext(publicGateways.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	for {
+		listSecurityGroupsOptions := &vpcv1.ListSecurityGroupsOptions{ResourceGroupID: resourceGroup}
+		if start != "" {
+			listSecurityGroupsOptions.Start = &start
+		}
+		securityGroups, response, err := sess.ListSecurityGroupsWithContext(context, listSecurityGroupsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListSecurityGroupsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing security groups: %s\n%s", err, response))
+		}
+		for _, securityGroup := range securityGroups.SecurityGroups {
+			if !defaults[*securityGroup.ID] {
+				inventory.Add("ibm_is_security_group", *securityGroup.Name, *securityGroup.ID)
+			}
+		}
+		start = flex.GetNext(securityGroups.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	for {
+		listNetworkAclsOptions := &vpcv1.ListNetworkAclsOptions{ResourceGroupID: resourceGroup}
+		if start != "" {
+			listNetworkAclsOptions.Start = &start
+		}
+		networkAcls, response, err := sess.ListNetworkAclsWithContext(context, listNetworkAclsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListNetworkAclsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing network ACLs: %s\n%s", err, response))
+		}
+		for _, networkACL := range networkAcls.NetworkAcls {
+			if !defaults[*networkACL.ID] {
+				inventory.Add("ibm_is_network_acl", *networkACL.Name, *networkACL.ID)
+			}
+		}
+		start = flex.GetNext(networkAcls.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	start = ""
+	for {
+		listInstancesOptions := &vpcv1.ListInstancesOptions{ResourceGroupID: resourceGroup}
+		if start != "" {
+			listInstancesOptions.Start = &start
+		}
+		instances, response, err := sess.ListInstancesWithContext(context, listInstancesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListInstancesWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing instances: %s\n%s", err, response))
+		}
+		for _, instance := range instances.Instances {
+			inventory.Add("ibm_is_instance", *instance.Name, *instance.ID)
+		}
+		start = flex.GetNext(instances.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	// Keys cannot be filtered by resource group in the API, so filter them here.
+	start = ""
+	for {
+		listKeysOptions := &vpcv1.ListKeysOptions{}
+		if start != "" {
+			listKeysOptions.Start = &start
+		}
+		keys, response, err := sess.ListKeysWithContext(context, listKeysOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListKeysWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing keys: %s\n%s", err, response))
+		}
+		for _, key := range keys.Keys {
+			if resourceGroup != nil && (key.ResourceGroup == nil || *key.ResourceGroup.ID != *resourceGroup) {
+				continue
+			}
+			inventory.Add("ibm_is_ssh_key", *key.Name, *key.ID)
+		}
+		start = flex.GetNext(keys.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	d.SetId(dataSourceIBMIsImportInventoryID(d))
+	if err = d.Set("resources", inventory.Resources()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources: %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMIsImportInventoryID returns a reasonable ID for the inventory.
+func dataSourceIBMIsImportInventoryID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsImportInventoryDataSourceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfimport-vpc-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsImportInventoryDataSourceConfigBasic(vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_import_inventory.is_import_inventory", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.ibm_is_import_inventory.is_import_inventory", "resources.*", map[string]string{
+						"type":    "ibm_is_vpc",
+						"name":    vpcname,
+						"address": "ibm_is_vpc." + vpcname,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsImportInventoryDataSourceConfigBasic(vpcname string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_vpc" "testacc_vpc" {
+			name = "%s"
+		}
+
+		data "ibm_is_import_inventory" "is_import_inventory" {
+			resource_group = ibm_is_vpc.testacc_vpc.resource_group
+		}
+	`, vpcname)
+}
@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsInstanceTemplateFromInstance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsInstanceTemplateFromInstanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The identifier of the instance to read the template attributes from.",
+			},
+			isInstanceTemplateAvailablePolicyHostFailure: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The action to perform if the compute host experiences a failure.",
+			},
+			isInstanceTemplateBootVolume: &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The boot volume configuration of the instance, without its name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceTemplateVolumeDeleteOnInstanceDelete: &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the boot volume is deleted when the instance is deleted.",
+						},
+						isInstanceTemplateBootEncryption: &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the root key that encrypts the boot volume, if it uses customer managed encryption.",
+						},
+						isInstanceTemplateBootProfile: &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The profile of the boot volume.",
+						},
+						isInstanceTemplateBootSize: &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The capacity of the boot volume in gigabytes.",
+						},
+					},
+				},
+			},
+			isInstanceTemplateImage: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the image of the instance.",
+			},
+			isInstanceTemplateKeys: &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The identifiers of the SSH keys the instance was initialized with.",
+			},
+			isInstanceTemplateMetadataServiceEnabled: &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the metadata service endpoint is available to the instance.",
+			},
+			isInstanceTemplateNetworkInterfaces: &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The secondary network interfaces of the instance, without their names and addresses.",
+				Elem:        dataSourceIBMIsInstanceTemplateFromInstanceNetworkInterfaceSchema(),
+			},
+			isInstanceTemplatePrimaryNetworkInterface: &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The primary network interface of the instance, without its name and address.",
+				Elem:        dataSourceIBMIsInstanceTemplateFromInstanceNetworkInterfaceSchema(),
+			},
+			isInstanceTemplateProfile: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The profile of the instance.",
+			},
+			isInstanceTemplateResourceGroup: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the resource group of the instance.",
+			},
+			isInstanceTotalVolumeBandwidth: &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of bandwidth in megabits per second allocated exclusively to the storage volumes of the instance.",
+			},
+			isInstanceTemplateVolumeAttachments: &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The data volumes of the instance, as volume prototypes so that every instance gets new volumes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceTemplateVolumeDeleteOnInstanceDelete: &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the volume is deleted when the instance is deleted.",
+						},
+						isInstanceTemplateVolAttachmentName: &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the volume attachment.",
+						},
+						isInstanceTemplateVolAttVolPrototype: &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The configuration of the volume to create for each instance.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isInstanceTemplateVolAttVolCapacity: &schema.Schema{
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The capacity of the volume in gigabytes.",
+									},
+									isInstanceTemplateVolAttVolEncryptionKey: &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The CRN of the root key that encrypts the volume, if it uses customer managed encryption.",
+									},
+									isInstanceTemplateVolAttVolIops: &schema.Schema{
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The maximum I/O operations per second of the volume.",
+									},
+									isInstanceTemplateVolAttVolProfile: &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The profile of the volume.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			isInstanceTemplateVPC: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the VPC of the instance.",
+			},
+			isInstanceTemplateZone: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the zone of the instance.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsInstanceTemplateFromInstanceNetworkInterfaceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isInstanceTemplateNicAllowIPSpoofing: &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether source IP spoofing is allowed on the network interface.",
+			},
+			isInstanceTemplateNicSecurityGroups: &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The identifiers of the security groups of the network interface.",
+			},
+			isInstanceTemplateNicSubnet: &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the subnet of the network interface.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsInstanceTemplateFromInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("instance").(string)
+	instance, response, err := vpcClient.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{ID: &id})
+	if err != nil {
+		log.Printf("[DEBUG] GetInstanceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting instance %s: %s\n%s", id, err, response))
+	}
+
+	d.SetId(*instance.ID)
+	if instance.AvailabilityPolicy != nil {
+		d.Set(isInstanceTemplateAvailablePolicyHostFailure, instance.AvailabilityPolicy.HostFailure)
+	}
+	if instance.Image != nil {
+		d.Set(isInstanceTemplateImage, instance.Image.ID)
+	}
+	if instance.MetadataService != nil {
+		d.Set(isInstanceTemplateMetadataServiceEnabled, instance.MetadataService.Enabled)
+	}
+	d.Set(isInstanceTemplateProfile, instance.Profile.Name)
+	d.Set(isInstanceTemplateResourceGroup, instance.ResourceGroup.ID)
+	d.Set(isInstanceTotalVolumeBandwidth, flex.IntValue(instance.TotalVolumeBandwidth))
+	d.Set(isInstanceTemplateVPC, instance.VPC.ID)
+	d.Set(isInstanceTemplateZone, instance.Zone.Name)
+
+	initialization, response, err := vpcClient.GetInstanceInitializationWithContext(context, &vpcv1.GetInstanceInitializationOptions{ID: instance.ID})
+	if err != nil {
+		log.Printf("[DEBUG] GetInstanceInitializationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the initialization of instance %s: %s\n%s", id, err, response))
+	}
+	keys := []string{}
+	for _, key := range initialization.Keys {
+		if key.ID != nil {
+			keys = append(keys, *key.ID)
+		}
+	}
+	d.Set(isInstanceTemplateKeys, keys)
+
+	primaryNetworkInterface := []map[string]interface{}{}
+	networkInterfaces := []map[string]interface{}{}
+	for _, nicRef := range instance.NetworkInterfaces {
+		nic, response, err := vpcClient.GetInstanceNetworkInterfaceWithContext(context, &vpcv1.GetInstanceNetworkInterfaceOptions{
+			InstanceID: instance.ID,
+			ID:         nicRef.ID,
+		})
+		if err != nil {
+			log.Printf("[DEBUG] GetInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting network interface %s of instance %s: %s\n%s", *nicRef.ID, id, err, response))
+		}
+		securityGroups := []string{}
+		for _, sg := range nic.SecurityGroups {
+			securityGroups = append(securityGroups, *sg.ID)
+		}
+		nicMap := map[string]interface{}{
+			isInstanceTemplateNicAllowIPSpoofing: *nic.AllowIPSpoofing,
+			isInstanceTemplateNicSecurityGroups:  flex.NewStringSet(schema.HashString, securityGroups),
+			isInstanceTemplateNicSubnet:          *nic.Subnet.ID,
+		}
+		if *nic.ID == *instance.PrimaryNetworkInterface.ID {
+			primaryNetworkInterface = append(primaryNetworkInterface, nicMap)
+		} else {
+			networkInterfaces = append(networkInterfaces, nicMap)
+		}
+	}
+	d.Set(isInstanceTemplatePrimaryNetworkInterface, primaryNetworkInterface)
+	d.Set(isInstanceTemplateNetworkInterfaces, networkInterfaces)
+
+	bootVolume := []map[string]interface{}{}
+	volumeAttachments := []map[string]interface{}{}
+	for _, attachmentRef := range instance.VolumeAttachments {
+		attachment, response, err := vpcClient.GetInstanceVolumeAttachmentWithContext(context, &vpcv1.GetInstanceVolumeAttachmentOptions{
+			InstanceID: instance.ID,
+			ID:         attachmentRef.ID,
+		})
+		if err != nil {
+			log.Printf("[DEBUG] GetInstanceVolumeAttachmentWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting volume attachment %s of instance %s: %s\n%s", *attachmentRef.ID, id, err, response))
+		}
+		if attachment.Volume == nil {
+			continue
+		}
+		volume, response, err := vpcClient.GetVolumeWithContext(context, &vpcv1.GetVolumeOptions{ID: attachment.Volume.ID})
+		if err != nil {
+			log.Printf("[DEBUG] GetVolumeWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting volume %s of instance %s: %s\n%s", *attachment.Volume.ID, id, err, response))
+		}
+		encryptionKey := ""
+		if volume.EncryptionKey != nil {
+			encryptionKey = *volume.EncryptionKey.CRN
+		}
+
+		if instance.BootVolumeAttachment != nil && *attachment.ID == *instance.BootVolumeAttachment.ID {
+			bootVolume = append(bootVolume, map[string]interface{}{
+				isInstanceTemplateVolumeDeleteOnInstanceDelete: *attachment.DeleteVolumeOnInstanceDelete,
+				isInstanceTemplateBootEncryption:               encryptionKey,
+				isInstanceTemplateBootProfile:                  *volume.Profile.Name,
+				isInstanceTemplateBootSize:                     flex.IntValue(volume.Capacity),
+			})
+			continue
+		}
+		volumeAttachments = append(volumeAttachments, map[string]interface{}{
+			isInstanceTemplateVolumeDeleteOnInstanceDelete: *attachment.DeleteVolumeOnInstanceDelete,
+			isInstanceTemplateVolAttachmentName:            *attachment.Name,
+			isInstanceTemplateVolAttVolPrototype: []map[string]interface{}{
+				{
+					isInstanceTemplateVolAttVolCapacity:      flex.IntValue(volume.Capacity),
+					isInstanceTemplateVolAttVolEncryptionKey: encryptionKey,
+					isInstanceTemplateVolAttVolIops:          flex.IntValue(volume.Iops),
+					isInstanceTemplateVolAttVolProfile:       *volume.Profile.Name,
+				},
+			},
+		})
+	}
+	d.Set(isInstanceTemplateBootVolume, bootVolume)
+	d.Set(isInstanceTemplateVolumeAttachments, volumeAttachments)
+
+	return nil
+}
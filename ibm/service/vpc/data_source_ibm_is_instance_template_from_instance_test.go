@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsInstanceTemplateFromInstanceDataSourceBasic(t *testing.T) {
+	randInt := acctest.RandIntRange(10, 100)
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDQ+WiiUR1Jg3oGSmB/2//GJ3XnotriBiGN6t3iwGces6sUsvRkza1t0Mf05DKZxC/zp0WvDTvbit2gTkF9sD37OZSn5aCJk1F5URk/JNPmz25ZogkICFL4OUfhrE3mnyKio6Bk1JIEIypR5PRtGxY9vFDUfruADDLfRi+dGwHF6U9RpvrDRo3FNtI8T0GwvWwFE7bg63vLz65CjYY5XqH9z/YWz/asH6BKumkwiphLGhuGn03+DV6DkIZqr3Oh13UDjMnTdgv1y/Kou5UM3CK1dVsmLRXPEf2KUWUq1EwRfrJXkPOrBwn8to+Yydo57FgrRM9Qw8uzvKmnVxfKW6iG3oSGA0L6ROuCq1lq0MD8ySLd56+d1ftSDaUq+0/Yt9vK3olzVP0/iZobD7chbGqTLMCzL4/CaIUR/UmX08EA0Oh0DdyAdj3UUNETAj3W8gBrV6xLR7fZAJ8roX2BKb4K8Ed3YqzgiY0zgjqvpBYl9xZl0jgVX0qMFaEa6+CeGI8= root@ffd8363b1226
+`)
+	vpcName := fmt.Sprintf("tf-vpc-%d", randInt)
+	subnetName := fmt.Sprintf("tf-subnet-%d", randInt)
+	sshKeyName := fmt.Sprintf("tf-ssh-%d", randInt)
+	instanceName := fmt.Sprintf("tf-instance-%d", randInt)
+	templateName := fmt.Sprintf("tf-template-%d", randInt)
+	name := "data.ibm_is_instance_template_from_instance.golden"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsInstanceTemplateFromInstanceDataSourceConfigBasic(vpcName, subnetName, sshKeyName, publicKey, instanceName, templateName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "profile", "ibm_is_instance.golden", "profile"),
+					resource.TestCheckResourceAttrPair(name, "image", "ibm_is_instance.golden", "image"),
+					resource.TestCheckResourceAttrPair(name, "vpc", "ibm_is_vpc.testacc_vpc", "id"),
+					resource.TestCheckResourceAttr(name, "zone", acc.ISZoneName),
+					resource.TestCheckResourceAttr(name, "keys.#", "1"),
+					resource.TestCheckResourceAttr(name, "primary_network_interface.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "primary_network_interface.0.subnet", "ibm_is_subnet.testacc_subnet", "id"),
+					resource.TestCheckResourceAttr(name, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(name, "boot_volume.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "boot_volume.0.profile"),
+					resource.TestCheckResourceAttr("ibm_is_instance_template.from_golden", "name", templateName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsInstanceTemplateFromInstanceDataSourceConfigBasic(vpcName, subnetName, sshKeyName, publicKey, instanceName, templateName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_instance" "golden" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		network_interfaces {
+			subnet = ibm_is_subnet.testacc_subnet.id
+			name   = "eth1"
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	}
+
+	data "ibm_is_instance_template_from_instance" "golden" {
+		instance = ibm_is_instance.golden.id
+	}
+
+	resource "ibm_is_instance_template" "from_golden" {
+		name    = "%s"
+		image   = data.ibm_is_instance_template_from_instance.golden.image
+		profile = data.ibm_is_instance_template_from_instance.golden.profile
+		vpc     = data.ibm_is_instance_template_from_instance.golden.vpc
+		zone    = data.ibm_is_instance_template_from_instance.golden.zone
+		keys    = data.ibm_is_instance_template_from_instance.golden.keys
+		primary_network_interface {
+			subnet          = data.ibm_is_instance_template_from_instance.golden.primary_network_interface.0.subnet
+			security_groups = data.ibm_is_instance_template_from_instance.golden.primary_network_interface.0.security_groups
+		}
+	}`, vpcName, subnetName, acc.ISZoneName, acc.ISCIDR, sshKeyName, publicKey, instanceName, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, templateName)
+}
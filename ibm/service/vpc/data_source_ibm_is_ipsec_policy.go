@@ -146,6 +146,12 @@ func DataSourceIBMIsIpsecPolicy() *schema.Resource {
 				Computed:    true,
 				Description: "The transform protocol used. Only `esp` is supported.",
 			},
+			"vpn_gateway_connections": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The VPN gateway connections that use this IPsec policy, with their VPN gateway and status.",
+				Elem:        dataSourceIBMIsPolicyVPNGatewayConnectionsSchema(),
+			},
 		},
 	}
 }
@@ -250,6 +256,17 @@ func dataSourceIBMIsIpsecPolicyRead(context context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("Error setting transform_protocol: %s", err))
 	}
 
+	listIpsecPolicyConnectionsOptions := &vpcv1.ListIpsecPolicyConnectionsOptions{}
+	listIpsecPolicyConnectionsOptions.SetID(*IPSecPolicy.ID)
+	ipsecPolicyConnections, response, err := vpcClient.ListIpsecPolicyConnectionsWithContext(context, listIpsecPolicyConnectionsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListIpsecPolicyConnectionsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListIpsecPolicyConnectionsWithContext failed %s\n%s", err, response))
+	}
+	if err = d.Set("vpn_gateway_connections", dataSourceIBMIsPolicyFlattenVPNGatewayConnections(ipsecPolicyConnections.Connections)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting vpn_gateway_connections: %s", err))
+	}
+
 	return nil
 }
 
@@ -32,6 +32,7 @@ func TestAccIBMIsIpsecPolicyDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_is_ipsec_policy.is_ipsec_policy", "pfs"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ipsec_policy.is_ipsec_policy", "resource_group.#"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ipsec_policy.is_ipsec_policy", "resource_type"),
+					resource.TestCheckResourceAttr("data.ibm_is_ipsec_policy.is_ipsec_policy", "vpn_gateway_connections.#", "0"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ipsec_policy.is_ipsec_policy", "transform_protocol"),
 				),
 			},
@@ -49,6 +50,7 @@ func TestAccIBMIsIpsecPolicyDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_is_ipsec_policy.is_ipsec_policy1", "pfs"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ipsec_policy.is_ipsec_policy1", "resource_group.#"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ipsec_policy.is_ipsec_policy1", "resource_type"),
+					resource.TestCheckResourceAttr("data.ibm_is_ipsec_policy.is_ipsec_policy1", "vpn_gateway_connections.#", "0"),
 					resource.TestCheckResourceAttrSet("data.ibm_is_ipsec_policy.is_ipsec_policy1", "transform_protocol"),
 				),
 			},
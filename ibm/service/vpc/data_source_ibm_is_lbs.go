@@ -26,6 +26,8 @@ func DataSourceIBMISLBS() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceIBMISLBSRead,
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			loadBalancers: {
 				Type:        schema.TypeList,
 				Description: "Collection of load balancers",
@@ -280,8 +282,10 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 	}
 	start := ""
 	allrecs := []vpcv1.LoadBalancer{}
+	maxItems := flex.GetMaxItems(d)
 	for {
 		listLoadBalancersOptions := &vpcv1.ListLoadBalancersOptions{}
+		listLoadBalancersOptions.Limit = flex.GetPageSize(d)
 		if start != "" {
 			listLoadBalancersOptions.Start = &start
 		}
@@ -291,10 +295,11 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 		}
 		start = flex.GetNext(lbs.Next)
 		allrecs = append(allrecs, lbs.LoadBalancers...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 
 	lbList := make([]map[string]interface{}, 0)
 
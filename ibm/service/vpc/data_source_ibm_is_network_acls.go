@@ -22,6 +22,8 @@ func DataSourceIBMIsNetworkAcls() *schema.Resource {
 		ReadContext: dataSourceIBMIsNetworkAclsRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -367,9 +369,11 @@ func dataSourceIBMIsNetworkAclsRead(context context.Context, d *schema.ResourceD
 	start := ""
 	allrecs := []vpcv1.NetworkACL{}
 	listNetworkAclsOptions := &vpcv1.ListNetworkAclsOptions{}
+	listNetworkAclsOptions.Limit = flex.GetPageSize(d)
 	if resource_group_id != "" {
 		listNetworkAclsOptions.ResourceGroupID = &resource_group_id
 	}
+	maxItems := flex.GetMaxItems(d)
 	for {
 		if start != "" {
 			listNetworkAclsOptions.Start = &start
@@ -381,10 +385,11 @@ func dataSourceIBMIsNetworkAclsRead(context context.Context, d *schema.ResourceD
 		}
 		start = flex.GetNext(networkACLCollection.Next)
 		allrecs = append(allrecs, networkACLCollection.NetworkAcls...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 
 	d.SetId(dataSourceIBMIsNetworkAclsID(d))
 
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIbmIsPlacementGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmIsPlacementGroupMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"placement_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the placement group.",
+			},
+			"strategy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The strategy of the placement group.",
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The virtual server instances placed in the placement group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN for this virtual server instance.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this virtual server instance.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this virtual server instance.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this virtual server instance.",
+						},
+						"profile": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the profile of this virtual server instance.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of this virtual server instance.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone of this virtual server instance.",
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of virtual server instances placed in the placement group.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmIsPlacementGroupMembersRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	placementGroupID := d.Get("placement_group").(string)
+	getPlacementGroupOptions := &vpcv1.GetPlacementGroupOptions{
+		ID: &placementGroupID,
+	}
+	placementGroup, response, err := vpcClient.GetPlacementGroupWithContext(context, getPlacementGroupOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetPlacementGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	listInstancesOptions := &vpcv1.ListInstancesOptions{
+		PlacementGroupID: &placementGroupID,
+	}
+	start := ""
+	allrecs := []vpcv1.Instance{}
+	for {
+		if start != "" {
+			listInstancesOptions.Start = &start
+		}
+		instances, response, err := vpcClient.ListInstancesWithContext(context, listInstancesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListInstancesWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+		start = flex.GetNext(instances.Next)
+		allrecs = append(allrecs, instances.Instances...)
+		if start == "" {
+			break
+		}
+	}
+
+	d.SetId(placementGroupID)
+	if err = d.Set("strategy", placementGroup.Strategy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting strategy: %s", err))
+	}
+	instances := make([]map[string]interface{}, 0, len(allrecs))
+	for _, instance := range allrecs {
+		instanceMap := map[string]interface{}{
+			"crn":    instance.CRN,
+			"href":   instance.Href,
+			"id":     instance.ID,
+			"name":   instance.Name,
+			"status": instance.Status,
+		}
+		if instance.Profile != nil {
+			instanceMap["profile"] = instance.Profile.Name
+		}
+		if instance.Zone != nil {
+			instanceMap["zone"] = instance.Zone.Name
+		}
+		instances = append(instances, instanceMap)
+	}
+	if err = d.Set("instances", instances); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting instances %s", err))
+	}
+	if err = d.Set("total_count", len(allrecs)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting total_count: %s", err))
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmIsPlacementGroupMembersDataSourceBasic(t *testing.T) {
+	placementGroupName := fmt.Sprintf("tf-pg-name%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIsPlacementGroupMembersDataSourceConfig(placementGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_is_placement_group_members.members", "id",
+						"ibm_is_placement_group.is_placement_group", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_placement_group_members.members", "strategy", "host_spread"),
+					resource.TestCheckResourceAttr("data.ibm_is_placement_group_members.members", "total_count", "0"),
+					resource.TestCheckResourceAttr("data.ibm_is_placement_group_members.members", "instances.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmIsPlacementGroupMembersDataSourceConfig(placementGroupName string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_placement_group" "is_placement_group" {
+			strategy = "host_spread"
+			name = "%s"
+		}
+
+		data "ibm_is_placement_group_members" "members" {
+			placement_group = ibm_is_placement_group.is_placement_group.id
+		}
+	`, placementGroupName)
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isPublicGatewaySubnets = "subnets"
+)
+
+func DataSourceIBMISPublicGatewaySubnets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISPublicGatewaySubnetsRead,
+
+		Schema: map[string]*schema.Schema{
+			isPublicGatewayID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the public gateway",
+			},
+			isPublicGatewayName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the public gateway",
+			},
+			isPublicGatewayVPC: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VPC of the public gateway",
+			},
+			isPublicGatewayZone: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone of the public gateway",
+			},
+			isPublicGatewaySubnets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The subnets that are attached to the public gateway",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the subnet",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the subnet",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the subnet",
+						},
+						"ipv4_cidr_block": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IPv4 range of the subnet, expressed in CIDR format",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISPublicGatewaySubnetsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	publicGatewayID := d.Get(isPublicGatewayID).(string)
+	getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
+		ID: &publicGatewayID,
+	}
+	publicGateway, response, err := sess.GetPublicGatewayWithContext(context, getPublicGatewayOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetPublicGatewayWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting public gateway (%s): %s\n%s", publicGatewayID, err, response))
+	}
+
+	subnets, err := isPublicGatewayAttachedSubnets(context, sess, publicGatewayID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subnetsInfo := make([]map[string]interface{}, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnetsInfo = append(subnetsInfo, map[string]interface{}{
+			"id":              *subnet.ID,
+			"name":            *subnet.Name,
+			"crn":             *subnet.CRN,
+			"ipv4_cidr_block": *subnet.Ipv4CIDRBlock,
+		})
+	}
+
+	d.SetId(publicGatewayID)
+	d.Set(isPublicGatewayName, publicGateway.Name)
+	d.Set(isPublicGatewayVPC, *publicGateway.VPC.ID)
+	d.Set(isPublicGatewayZone, *publicGateway.Zone.Name)
+	if err = d.Set(isPublicGatewaySubnets, subnetsInfo); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subnets: %s", err))
+	}
+
+	return nil
+}
+
+// isPublicGatewayAttachedSubnets returns the subnets that have the public gateway attached.
+// Subnets cannot be listed by public gateway, so every subnet is listed and filtered here.
+func isPublicGatewayAttachedSubnets(context context.Context, sess *vpcv1.VpcV1, publicGatewayID string) ([]vpcv1.Subnet, error) {
+	subnets := []vpcv1.Subnet{}
+	start := ""
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{}
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnetCollection, response, err := sess.ListSubnetsWithContext(context, listSubnetsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListSubnetsWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("[ERROR] Error listing subnets: %s\n%s", err, response)
+		}
+		for _, subnet := range subnetCollection.Subnets {
+			if subnet.PublicGateway != nil && *subnet.PublicGateway.ID == publicGatewayID {
+				subnets = append(subnets, subnet)
+			}
+		}
+		start = flex.GetNext(subnetCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return subnets, nil
+}
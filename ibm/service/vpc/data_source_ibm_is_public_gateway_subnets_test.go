@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISPublicGatewaySubnetsDatasource_basic(t *testing.T) {
+	pgname := fmt.Sprintf("tfnw-pg-%d", acctest.RandIntRange(10, 100))
+	vpcname := fmt.Sprintf("tfsubnet-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsubnet-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISPublicGatewaySubnetsDataSourceConfig(vpcname, name, acc.ISZoneName, pgname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_is_public_gateway_subnets.shared", "name", "ibm_is_public_gateway.testacc_pg", "name"),
+					resource.TestCheckResourceAttr("data.ibm_is_public_gateway_subnets.shared", "subnets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_public_gateway_subnets.shared", "subnets.0.id", "ibm_is_subnet.testacc_subnet", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISPublicGatewaySubnetsDataSourceConfig(vpcname, name, zone, pgname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_public_gateway" "testacc_pg" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name 						= "%s"
+		vpc 						= ibm_is_vpc.testacc_vpc.id
+		zone 						= "%s"
+		total_ipv4_address_count 	= 16
+		public_gateway 				= ibm_is_public_gateway.testacc_pg.id
+	}
+
+	data "ibm_is_public_gateway_subnets" "shared" {
+		public_gateway 	= ibm_is_public_gateway.testacc_pg.id
+		depends_on		= [ibm_is_subnet.testacc_subnet]
+	}
+	`, vpcname, pgname, zone, name, zone)
+}
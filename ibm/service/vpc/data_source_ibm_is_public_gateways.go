@@ -22,6 +22,8 @@ func DataSourceIBMISPublicGateways() *schema.Resource {
 		Read: dataSourceIBMISPublicGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			isPublicGatewayResourceGroup: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -151,8 +153,10 @@ func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) er
 	}
 	start := ""
 	allrecs := []vpcv1.PublicGateway{}
+	maxItems := flex.GetMaxItems(d)
 	for {
 		listPublicGatewaysOptions := &vpcv1.ListPublicGatewaysOptions{}
+		listPublicGatewaysOptions.Limit = flex.GetPageSize(d)
 		if start != "" {
 			listPublicGatewaysOptions.Start = &start
 		}
@@ -165,10 +169,11 @@ func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) er
 		}
 		start = flex.GetNext(publicgws.Next)
 		allrecs = append(allrecs, publicgws.PublicGateways...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 	publicgwInfo := make([]map[string]interface{}, 0)
 	for _, publicgw := range allrecs {
 		id := *publicgw.ID
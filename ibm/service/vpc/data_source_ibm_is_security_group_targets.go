@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,6 +25,13 @@ func DataSourceIBMISSecurityGroupTargets() *schema.Resource {
 				Description: "Security group id",
 			},
 
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_target", isSecurityGroupResourceType),
+				Description:  "Filters the targets to the ones of this resource type",
+			},
+
 			"targets": {
 				Type:        schema.TypeList,
 				Description: "List of targets",
@@ -75,6 +83,7 @@ func dataSourceIBMISSecurityGroupTargetsRead(d *schema.ResourceData, meta interf
 	}
 
 	securityGroupID := d.Get("security_group").(string)
+	resourceType := d.Get("resource_type").(string)
 
 	// Support for pagination
 	start := ""
@@ -105,6 +114,9 @@ func dataSourceIBMISSecurityGroupTargetsRead(d *schema.ResourceData, meta interf
 	targets := make([]map[string]interface{}, 0)
 	for _, securityGroupTargetReferenceIntf := range allrecs {
 		securityGroupTargetReference := securityGroupTargetReferenceIntf.(*vpcv1.SecurityGroupTargetReference)
+		if resourceType != "" && (securityGroupTargetReference.ResourceType == nil || *securityGroupTargetReference.ResourceType != resourceType) {
+			continue
+		}
 		tr := map[string]interface{}{
 			"name":   *securityGroupTargetReference.Name,
 			"target": *securityGroupTargetReference.ID,
@@ -120,6 +132,10 @@ func dataSourceIBMISSecurityGroupTargetsRead(d *schema.ResourceData, meta interf
 		targets = append(targets, tr)
 	}
 	d.Set("targets", targets)
-	d.SetId(securityGroupID)
+	if resourceType != "" {
+		d.SetId(fmt.Sprintf("%s/%s", securityGroupID, resourceType))
+	} else {
+		d.SetId(securityGroupID)
+	}
 	return nil
 }
@@ -34,6 +34,8 @@ func TestAccIBMISSecurityGroupTargets_basic(t *testing.T) {
 				Config: testAccCheckIBMISsecurityGroupTargetsConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMISSecurityGroupTargetsExists("ibm_is_security_group_target.testacc_security_group_target", &securityGroup),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_security_group_targets.testacc_security_group_lb_targets", "targets.0.resource_type", "load_balancer"),
 					// resource.TestCheckResourceAttr(
 					// 	"ibm_is_security_group_target.testacc_security_group_target", "name", lbname),
 					// resource.TestCheckResourceAttrSet(
@@ -148,5 +150,11 @@ func testAccCheckIBMISsecurityGroupTargetsConfig(vpcname, subnetname, zoneName,
 		security_group = ibm_is_security_group.testacc_security_group_one.id
 	}
 
+	data "ibm_is_security_group_targets" "testacc_security_group_lb_targets" {
+		security_group = ibm_is_security_group.testacc_security_group_one.id
+		resource_type  = "load_balancer"
+		depends_on     = [ibm_is_security_group_target.testacc_security_group_target]
+	}
+
 	`, vpcname, subnetname, zoneName, cidr, name, lbname)
 }
@@ -22,6 +22,8 @@ func DataSourceIBMIsSecurityGroups() *schema.Resource {
 		ReadContext: dataSourceIBMIsSecurityGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			"resource_group": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -336,6 +338,8 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 	if vpcName != "" {
 		listSecurityGroupsOptions.VPCName = &vpcName
 	}
+	listSecurityGroupsOptions.Limit = flex.GetPageSize(d)
+	maxItems := flex.GetMaxItems(d)
 	for {
 
 		if start != "" {
@@ -350,10 +354,11 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 		start = flex.GetNext(securityGroupCollection.Next)
 		allrecs = append(allrecs, securityGroupCollection.SecurityGroups...)
 
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 
 	d.SetId(dataSourceIBMIsSecurityGroupsID(d))
 	err = d.Set("security_groups", dataSourceSecurityGroupCollectionFlattenSecurityGroups(allrecs, d, meta))
@@ -23,6 +23,8 @@ func DataSourceSnapshots() *schema.Resource {
 		Read: dataSourceIBMISSnapshotsRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 
 			isSnapshotResourceGroup: {
 				Type:        schema.TypeString,
@@ -239,6 +241,7 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 	}
 	start := ""
 	allrecs := []vpcv1.Snapshot{}
+	maxItems := flex.GetMaxItems(d)
 	for {
 		listSnapshotOptions := &vpcv1.ListSnapshotsOptions{}
 		if start != "" {
@@ -268,6 +271,7 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 			tagFilter := tagFilterOk.(string)
 			listSnapshotOptions.Tag = &tagFilter
 		}
+		listSnapshotOptions.Limit = flex.GetPageSize(d)
 
 		snapshots, response, err := sess.ListSnapshots(listSnapshotOptions)
 		if err != nil {
@@ -275,10 +279,11 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 		}
 		start = flex.GetNext(snapshots.Next)
 		allrecs = append(allrecs, snapshots.Snapshots...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 
 	snapshotsInfo := make([]map[string]interface{}, 0)
 	for _, snapshot := range allrecs {
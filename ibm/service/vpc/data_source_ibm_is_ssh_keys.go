@@ -34,6 +34,8 @@ func DataSourceIBMIsSshKeys() *schema.Resource {
 		ReadContext: dataSourceIBMIsSshKeysRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			isKeys: &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -132,7 +134,9 @@ func dataSourceIBMIsSshKeysRead(context context.Context, d *schema.ResourceData,
 	start := ""
 	allrecs := []vpcv1.Key{}
 	listKeysOptions := &vpcv1.ListKeysOptions{}
+	listKeysOptions.Limit = flex.GetPageSize(d)
 
+	maxItems := flex.GetMaxItems(d)
 	for {
 		if start != "" {
 			listKeysOptions.Start = &start
@@ -147,11 +151,12 @@ func dataSourceIBMIsSshKeysRead(context context.Context, d *schema.ResourceData,
 		start = flex.GetNext(keyCollection.Next)
 		allrecs = append(allrecs, keyCollection.Keys...)
 
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 
 	d.SetId(dataSourceIBMIsSshKeysID(d))
 
@@ -5,6 +5,7 @@ package vpc
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
@@ -24,6 +25,8 @@ func DataSourceIBMISSubnets() *schema.Resource {
 		Read: dataSourceIBMISSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			isSubnetResourceGroupID: {
 				Type:        schema.TypeString,
 				Description: "Resource Group ID",
@@ -88,6 +91,16 @@ func DataSourceIBMISSubnets() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"used_ipv4_address_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of IPv4 addresses in the subnet that are not available",
+						},
+						"ipv4_address_utilization": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The percentage of IPv4 addresses in the subnet that are not available",
+						},
 						"vpc": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -187,6 +200,8 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 	if resourceTableName != "" {
 		options.SetRoutingTableName(resourceTableName)
 	}
+	options.Limit = flex.GetPageSize(d)
+	maxItems := flex.GetMaxItems(d)
 
 	for {
 		if start != "" {
@@ -198,10 +213,11 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 		}
 		start = flex.GetNext(subnets.Next)
 		allrecs = append(allrecs, subnets.Subnets...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 	subnetsInfo := make([]map[string]interface{}, 0)
 	for _, subnet := range allrecs {
 
@@ -219,6 +235,11 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 			"vpc":                          *subnet.VPC.ID,
 			"zone":                         *subnet.Zone.Name,
 		}
+		used := *subnet.TotalIpv4AddressCount - *subnet.AvailableIpv4AddressCount
+		l["used_ipv4_address_count"] = used
+		if *subnet.TotalIpv4AddressCount > 0 {
+			l["ipv4_address_utilization"] = math.Round(float64(used)*10000/float64(*subnet.TotalIpv4AddressCount)) / 100
+		}
 		if subnet.PublicGateway != nil {
 			l["public_gateway"] = *subnet.PublicGateway.ID
 		}
@@ -47,6 +47,8 @@ func TestAccIBMISSubnetsDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resName, "subnets.0.available_ipv4_address_count"),
 					resource.TestCheckResourceAttrSet(resName, "subnets.0.network_acl"),
 					resource.TestCheckResourceAttrSet(resName, "subnets.0.total_ipv4_address_count"),
+					resource.TestCheckResourceAttrSet(resName, "subnets.0.used_ipv4_address_count"),
+					resource.TestCheckResourceAttrSet(resName, "subnets.0.ipv4_address_utilization"),
 					resource.TestCheckResourceAttrSet(resName, "subnets.0.vpc"),
 				),
 			},
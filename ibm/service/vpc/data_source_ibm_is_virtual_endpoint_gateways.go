@@ -23,6 +23,8 @@ func DataSourceIBMISEndpointGateways() *schema.Resource {
 		Read:     dataSourceIBMISEndpointGatewaysRead,
 		Importer: &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -168,6 +170,7 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 	start := ""
 	allrecs := []vpcv1.EndpointGateway{}
 	options := sess.NewListEndpointGatewaysOptions()
+	options.Limit = flex.GetPageSize(d)
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
 		options.ResourceGroupID = &resGroup
@@ -176,6 +179,7 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 		name := nameintf.(string)
 		options.Name = &name
 	}
+	maxItems := flex.GetMaxItems(d)
 	for {
 
 		if start != "" {
@@ -187,10 +191,11 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 		}
 		start = flex.GetNext(result.Next)
 		allrecs = append(allrecs, result.EndpointGateways...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 	endpointGateways := []map[string]interface{}{}
 	for _, endpointGateway := range allrecs {
 		endpointGatewayOutput := map[string]interface{}{}
@@ -89,6 +89,8 @@ func DataSourceIBMIsVolumes() *schema.Resource {
 		ReadContext: dataSourceIBMIsVolumesRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			"volume_name": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -544,6 +546,7 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 
 	start := ""
 	allrecs := []vpcv1.Volume{}
+	maxItems := flex.GetMaxItems(d)
 
 	// list
 	for {
@@ -557,6 +560,7 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 		if zoneName != "" {
 			listVolumesOptions.ZoneName = &zoneName
 		}
+		listVolumesOptions.Limit = flex.GetPageSize(d)
 		volumeCollection, response, err := vpcClient.ListVolumesWithContext(context, listVolumesOptions)
 		if err != nil {
 			log.Printf("[DEBUG] ListVolumesWithContext failed %s\n%s", err, response)
@@ -566,11 +570,12 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 		start = flex.GetNext(volumeCollection.Next)
 		allrecs = append(allrecs, volumeCollection.Volumes...)
 
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 
 	d.SetId(dataSourceIBMIsVolumesID(d))
 
@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Data source that checks a planned subnet layout before any subnet is created. All the problems
+// found are reported together, instead of one subnet creation failing at a time during apply.
+func DataSourceIBMIsVpcSubnetLayout() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVpcSubnetLayoutRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"vpc", "address_prefixes"},
+				Description:  "The VPC of the subnets, its address prefixes are added to address_prefixes",
+			},
+			"address_prefixes": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"vpc", "address_prefixes"},
+				Description:  "Address prefixes that are not created yet",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The zone of the address prefix",
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CIDR block of the address prefix",
+						},
+					},
+				},
+			},
+			"subnets": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The subnets to check",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the subnet",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The zone of the subnet",
+						},
+						"ipv4_cidr_block": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IPv4 range of the subnet",
+						},
+					},
+				},
+			},
+			"check_existing_subnets": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Also check the subnets against the subnets of the VPC with other names",
+			},
+			"min_zones": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "The minimum number of zones the subnets must be spread across",
+			},
+			"fail_on_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Fail the read when issues are found, otherwise they are only reported in issues",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the subnet layout has no issues",
+			},
+			"issues": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The issues found in the subnet layout",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// subnetLayoutRange is an address prefix or a subnet of the layout.
+type subnetLayoutRange struct {
+	name    string
+	zone    string
+	network *net.IPNet
+}
+
+func dataSourceIBMIsVpcSubnetLayoutRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	issues := []string{}
+
+	prefixes := []subnetLayoutRange{}
+	for i, p := range d.Get("address_prefixes").([]interface{}) {
+		prefix := p.(map[string]interface{})
+		cidr := prefix["cidr"].(string)
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("address_prefixes.%d: %s is not a valid CIDR block", i, cidr))
+			continue
+		}
+		prefixes = append(prefixes, subnetLayoutRange{name: cidr, zone: prefix["zone"].(string), network: network})
+	}
+
+	subnets := []subnetLayoutRange{}
+	names := map[string]bool{}
+	for i, s := range d.Get("subnets").([]interface{}) {
+		subnet := s.(map[string]interface{})
+		name := subnet["name"].(string)
+		cidr := subnet["ipv4_cidr_block"].(string)
+		if names[name] {
+			issues = append(issues, fmt.Sprintf("subnet %s: the name is used by more than one subnet", name))
+		}
+		names[name] = true
+		ip, network, err := net.ParseCIDR(cidr)
+		if err != nil || ip.To4() == nil {
+			issues = append(issues, fmt.Sprintf("subnets.%d (%s): %s is not a valid IPv4 CIDR block", i, name, cidr))
+			continue
+		}
+		if !ip.Equal(network.IP) {
+			issues = append(issues, fmt.Sprintf("subnet %s: %s has host bits set, the network address is %s", name, cidr, network))
+		}
+		subnets = append(subnets, subnetLayoutRange{name: name, zone: subnet["zone"].(string), network: network})
+	}
+
+	existing := []subnetLayoutRange{}
+	if vpcID, ok := d.GetOk("vpc"); ok {
+		sess, err := meta.(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		start := ""
+		for {
+			listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
+			listVpcAddressPrefixesOptions.SetVPCID(vpcID.(string))
+			if start != "" {
+				listVpcAddressPrefixesOptions.Start = &start
+			}
+			addressPrefixCollection, response, err := sess.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
+			if err != nil {
+				log.Printf("[DEBUG] ListVpcAddressPrefixesWithContext failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error listing address prefixes of VPC %s: %s\n%s", vpcID, err, response))
+			}
+			for _, addressPrefix := range addressPrefixCollection.AddressPrefixes {
+				if _, network, err := net.ParseCIDR(*addressPrefix.CIDR); err == nil {
+					prefixes = append(prefixes, subnetLayoutRange{name: *addressPrefix.CIDR, zone: *addressPrefix.Zone.Name, network: network})
+				}
+			}
+			start = flex.GetNext(addressPrefixCollection.Next)
+			if start == "" {
+				break
+			}
+		}
+
+		if d.Get("check_existing_subnets").(bool) {
+			start = ""
+			for {
+				// Subnets cannot be filtered by VPC in the API, so filter them here.
+				listSubnetsOptions := &vpcv1.ListSubnetsOptions{}
+				if start != "" {
+					listSubnetsOptions.Start = &start
+				}
+				subnetCollection, response, err := sess.ListSubnetsWithContext(context, listSubnetsOptions)
+				if err != nil {
+					log.Printf("[DEBUG] ListSubnetsWithContext failed %s\n%s", err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error listing subnets of VPC %s: %s\n%s", vpcID, err, response))
+				}
+				for _, subnet := range subnetCollection.Subnets {
+					// A subnet of the layout that already exists would be compared with itself.
+					if *subnet.VPC.ID != vpcID.(string) || names[*subnet.Name] || subnet.Ipv4CIDRBlock == nil {
+						continue
+					}
+					if _, network, err := net.ParseCIDR(*subnet.Ipv4CIDRBlock); err == nil {
+						existing = append(existing, subnetLayoutRange{name: *subnet.Name, zone: *subnet.Zone.Name, network: network})
+					}
+				}
+				start = flex.GetNext(subnetCollection.Next)
+				if start == "" {
+					break
+				}
+			}
+		}
+	}
+
+	issues = append(issues, isSubnetLayoutIssues(prefixes, subnets, existing)...)
+
+	zones := map[string]bool{}
+	for _, subnet := range subnets {
+		zones[subnet.zone] = true
+	}
+	if minZones := d.Get("min_zones").(int); len(zones) < minZones {
+		issues = append(issues, fmt.Sprintf("the subnets are in %d zones, at least %d are required", len(zones), minZones))
+	}
+
+	d.SetId(dataSourceIBMIsVpcSubnetLayoutID(d))
+	if err := d.Set("valid", len(issues) == 0); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting valid: %s", err))
+	}
+	if err := d.Set("issues", issues); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting issues: %s", err))
+	}
+
+	if !d.Get("fail_on_error").(bool) {
+		return nil
+	}
+	var diags diag.Diagnostics
+	for _, issue := range issues {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid subnet layout",
+			Detail:   issue,
+		})
+	}
+	return diags
+}
+
+// isSubnetLayoutIssues checks that every subnet is in an address prefix of its zone, and that the
+// subnets overlap neither each other nor the existing subnets.
+func isSubnetLayoutIssues(prefixes, subnets, existing []subnetLayoutRange) []string {
+	issues := []string{}
+	for i, subnet := range subnets {
+		var contained, otherZone *subnetLayoutRange
+		for j := range prefixes {
+			if !isSubnetLayoutContains(prefixes[j].network, subnet.network) {
+				continue
+			}
+			if prefixes[j].zone == subnet.zone {
+				contained = &prefixes[j]
+				break
+			}
+			otherZone = &prefixes[j]
+		}
+		if contained == nil {
+			if otherZone != nil {
+				issues = append(issues, fmt.Sprintf("subnet %s: %s is in the address prefix %s of zone %s, not of zone %s", subnet.name, subnet.network, otherZone.name, otherZone.zone, subnet.zone))
+			} else {
+				issues = append(issues, fmt.Sprintf("subnet %s: %s is not in an address prefix of zone %s", subnet.name, subnet.network, subnet.zone))
+			}
+		}
+		for _, other := range subnets[i+1:] {
+			if isSubnetLayoutOverlaps(subnet.network, other.network) {
+				issues = append(issues, fmt.Sprintf("subnet %s: %s overlaps %s of subnet %s", subnet.name, subnet.network, other.network, other.name))
+			}
+		}
+		for _, other := range existing {
+			if isSubnetLayoutOverlaps(subnet.network, other.network) {
+				issues = append(issues, fmt.Sprintf("subnet %s: %s overlaps %s of the existing subnet %s", subnet.name, subnet.network, other.network, other.name))
+			}
+		}
+	}
+	return issues
+}
+
+func isSubnetLayoutContains(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+func isSubnetLayoutOverlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// dataSourceIBMIsVpcSubnetLayoutID returns a reasonable ID for the subnet layout check.
+func dataSourceIBMIsVpcSubnetLayoutID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
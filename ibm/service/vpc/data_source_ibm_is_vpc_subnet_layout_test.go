@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVpcSubnetLayoutDatasource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflayout-vpc-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVpcSubnetLayoutDataSourceConfig(vpcname, "10.240.0.0/24", "10.240.64.0/24", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_subnet_layout.layout", "valid", "true"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_subnet_layout.layout", "issues.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVpcSubnetLayoutDataSourceConfig(vpcname, "10.240.0.0/24", "10.240.0.128/25", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_subnet_layout.layout", "valid", "false"),
+					// The second subnet overlaps the first one and is outside the address prefixes of its zone.
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_subnet_layout.layout", "issues.#", "2"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMISVpcSubnetLayoutDataSourceConfig(vpcname, "10.240.0.0/24", "10.240.0.128/25", true),
+				ExpectError: regexp.MustCompile("Invalid subnet layout"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVpcSubnetLayoutDataSourceConfig(vpcname, cidr1, cidr2 string, failOnError bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	data "ibm_is_vpc_subnet_layout" "layout" {
+		vpc           = ibm_is_vpc.testacc_vpc.id
+		fail_on_error = %t
+		min_zones     = 2
+
+		subnets {
+			name            = "subnet-1"
+			zone            = "%s-1"
+			ipv4_cidr_block = "%s"
+		}
+		subnets {
+			name            = "subnet-2"
+			zone            = "%s-2"
+			ipv4_cidr_block = "%s"
+		}
+	}
+	`, vpcname, failOnError, acc.RegionName, cidr1, acc.RegionName, cidr2)
+}
@@ -28,6 +28,8 @@ func DataSourceIBMISVPCs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceIBMISVPCListRead,
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -333,6 +335,8 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 		classicAccess := classicAccessIntf.(bool)
 		listOptions.ClassicAccess = &classicAccess
 	}
+	listOptions.Limit = flex.GetPageSize(d)
+	maxItems := flex.GetMaxItems(d)
 	for {
 
 		if start != "" {
@@ -345,10 +349,11 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 		}
 		start = flex.GetNext(result.Next)
 		allrecs = append(allrecs, result.Vpcs...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 
 	vpcs := make([]map[string]interface{}, 0)
 	for _, vpc := range allrecs {
@@ -24,6 +24,8 @@ func DataSourceIBMISVPNGateways() *schema.Resource {
 		Read: dataSourceIBMVPNGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
+			flex.MaxItems: flex.MaxItemsSchema(),
+			flex.PageSize: flex.PageSizeSchema(100),
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -186,6 +188,7 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	listvpnGWOptions := sess.NewListVPNGatewaysOptions()
+	listvpnGWOptions.Limit = flex.GetPageSize(d)
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
 		listvpnGWOptions.ResourceGroupID = &resGroup
@@ -196,6 +199,7 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	start := ""
 	allrecs := []vpcv1.VPNGatewayIntf{}
+	maxItems := flex.GetMaxItems(d)
 	for {
 		if start != "" {
 			listvpnGWOptions.Start = &start
@@ -206,10 +210,11 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 		}
 		start = flex.GetNext(availableVPNGateways.Next)
 		allrecs = append(allrecs, availableVPNGateways.VPNGateways...)
-		if start == "" {
+		if start == "" || flex.MaxItemsReached(maxItems, len(allrecs)) {
 			break
 		}
 	}
+	allrecs = allrecs[:flex.MaxItemsLen(maxItems, len(allrecs))]
 
 	vpngateways := make([]map[string]interface{}, 0)
 	for _, instance := range allrecs {
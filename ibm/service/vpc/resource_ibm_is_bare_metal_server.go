@@ -1239,7 +1239,7 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 	}
 	d.SetId(*bms.ID)
 	log.Printf("[INFO] Bare Metal Server : %s", *bms.ID)
-	_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForBareMetalServerAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -2316,7 +2316,7 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return err
 		}
-		_, err = isWaitForBareMetalServerAvailable(sess, id, d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForBareMetalServerAvailable(context, sess, id, d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return err
 		}
@@ -2347,11 +2347,11 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			action = actionOk.(string)
 		}
 		if action == "start" {
-			isBareMetalServerStart(sess, d.Id(), d, 10)
+			isBareMetalServerStart(context, sess, d.Id(), d, 10)
 		} else if action == "stop" {
-			isBareMetalServerStop(sess, d.Id(), d, 10)
+			isBareMetalServerStop(context, sess, d.Id(), d, 10)
 		} else if action == "restart" {
-			isBareMetalServerRestart(sess, d.Id(), d, 10)
+			isBareMetalServerRestart(context, sess, d.Id(), d, 10)
 		}
 	}
 	return nil
@@ -2398,7 +2398,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 		if err != nil && response != nil && response.StatusCode != 204 {
 			return fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
 		}
-		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
+		isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutDelete), id, d)
 
 	}
 	options := &vpcv1.DeleteBareMetalServerOptions{
@@ -2408,7 +2408,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Deleting Bare Metal Server : %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForBareMetalServerDeleted(context, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -2416,7 +2416,7 @@ func bareMetalServerDelete(context context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func isWaitForBareMetalServerDeleted(bmsC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForBareMetalServerDeleted(context context.Context, bmsC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -2428,7 +2428,7 @@ func isWaitForBareMetalServerDeleted(bmsC *vpcv1.VpcV1, id string, timeout time.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -2450,7 +2450,7 @@ func isBareMetalServerDeleteRefreshFunc(bmsC *vpcv1.VpcV1, id string) resource.S
 	}
 }
 
-func isWaitForBareMetalServerAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerAvailable(context context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be available.", id)
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
@@ -2461,7 +2461,7 @@ func isWaitForBareMetalServerAvailable(client *vpcv1.VpcV1, id string, timeout t
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
@@ -2518,7 +2518,7 @@ func isBareMetalServerRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.Reso
 	}
 }
 
-func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerActionStop(context context.Context, bmsC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isBareMetalServerStatusRunning, isBareMetalServerStatusPending, isBareMetalServerActionStatusStopping},
@@ -2549,7 +2549,7 @@ func isWaitForBareMetalServerActionStop(bmsC *vpcv1.VpcV1, timeout time.Duration
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
@@ -2578,7 +2578,7 @@ func isBareMetalServerRestartStopAction(bmsC *vpcv1.VpcV1, id string, d *schema.
 	}
 }
 
-func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerStart(context context.Context, bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	createbmsactoptions := &vpcv1.StartBareMetalServerOptions{
 		ID: &id,
 	}
@@ -2589,13 +2589,13 @@ func isBareMetalServerStart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerAvailable(context, bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
-func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerStop(context context.Context, bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	stoppingType := "soft"
 	createbmsactoptions := &vpcv1.StopBareMetalServerOptions{
 		ID:   &id,
@@ -2608,13 +2608,13 @@ func isBareMetalServerStop(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData,
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server Action stop: %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerActionStop(bmsC, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
+	_, err = isWaitForBareMetalServerActionStop(context, bmsC, d.Timeout(schema.TimeoutUpdate), d.Id(), d)
 	if err != nil {
 		return nil, err
 	}
 	return nil, nil
 }
-func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
+func isBareMetalServerRestart(context context.Context, bmsC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int) (interface{}, error) {
 	createbmsactoptions := &vpcv1.RestartBareMetalServerOptions{
 		ID: &id,
 	}
@@ -2625,7 +2625,7 @@ func isBareMetalServerRestart(bmsC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 		}
 		return nil, fmt.Errorf("[ERROR] Error creating Bare Metal Server action restart: %s\n%s", err, response)
 	}
-	_, err = isWaitForBareMetalServerAvailable(bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForBareMetalServerAvailable(context, bmsC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return nil, err
 	}
@@ -2664,7 +2664,7 @@ func resourceStopServerIfRunning(id, stoppingType string, d *schema.ResourceData
 		if err != nil && response != nil && response.StatusCode != 204 {
 			return fmt.Errorf("[ERROR] Error stopping Bare Metal Server (%s): %s\n%s", id, err, response)
 		}
-		isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutDelete), id, d)
+		isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutDelete), id, d)
 
 	}
 	return nil
@@ -2693,7 +2693,7 @@ func resourceStartServerIfStopped(id, stoppingType string, d *schema.ResourceDat
 			}
 			return fmt.Errorf("[ERROR] Error creating Bare Metal Server action start : %s\n%s", err, response)
 		}
-		_, err = isWaitForBareMetalServerAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForBareMetalServerAvailable(context, sess, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return err
 		}
@@ -145,7 +145,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		_, waitErr := isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutCreate), bareMetalServerId, d)
+		_, waitErr := isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutCreate), bareMetalServerId, d)
 		if waitErr != nil {
 			return diag.FromErr(waitErr)
 		}
@@ -159,7 +159,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+		_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 		if waitErr != nil {
 			return diag.FromErr(waitErr)
 		}
@@ -172,7 +172,7 @@ func resourceIBMISBareMetalServerActionCreate(context context.Context, d *schema
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+		_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 		if waitErr != nil {
 			return diag.FromErr(waitErr)
 		}
@@ -259,7 +259,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			_, waitErr := isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutUpdate), bareMetalServerId, d)
+			_, waitErr := isWaitForBareMetalServerActionStop(context, sess, d.Timeout(schema.TimeoutUpdate), bareMetalServerId, d)
 			if waitErr != nil {
 				return diag.FromErr(waitErr)
 			}
@@ -272,7 +272,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+			_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 			if waitErr != nil {
 				return diag.FromErr(waitErr)
 			}
@@ -285,7 +285,7 @@ func resourceIBMISBareMetalServerActionUpdate(context context.Context, d *schema
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			_, waitErr := isWaitForBareMetalServerActionAvailable(sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
+			_, waitErr := isWaitForBareMetalServerActionAvailable(context, sess, bareMetalServerId, d.Timeout(schema.TimeoutDelete), d)
 			if waitErr != nil {
 				return diag.FromErr(waitErr)
 			}
@@ -303,7 +303,7 @@ func resourceIBMISBareMetalServerActionDelete(context context.Context, d *schema
 	return nil
 }
 
-func isWaitForBareMetalServerActionAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForBareMetalServerActionAvailable(context context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for Bare Metal Server (%s) to be running.", id)
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
@@ -314,7 +314,7 @@ func isWaitForBareMetalServerActionAvailable(client *vpcv1.VpcV1, id string, tim
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isBareMetalServerActionRefreshFunc(client *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
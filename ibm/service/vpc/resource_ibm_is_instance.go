@@ -177,6 +177,10 @@ func ResourceIBMISInstance() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.InstanceProfileValidate(diff)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceImageProfileValidate(diff, v)
+				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceTagsCustomizeDiff(diff)
@@ -4253,3 +4257,54 @@ func GetInstanceMetadataServiceOptions(d *schema.ResourceData) (metadataService
 	}
 	return nil
 }
+
+// resourceIBMISInstanceImageProfileValidate checks at plan time that the image
+// can be provisioned and that its architecture matches the architecture of
+// the profile, which would otherwise only fail once the instance is created.
+func resourceIBMISInstanceImageProfileValidate(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(isInstanceImage) && !diff.HasChange(isInstanceProfile) {
+		return nil
+	}
+	if !diff.NewValueKnown(isInstanceImage) || !diff.NewValueKnown(isInstanceProfile) {
+		return nil
+	}
+	imageID := diff.Get(isInstanceImage).(string)
+	profileName := diff.Get(isInstanceProfile).(string)
+	if imageID == "" || profileName == "" {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+
+	getImageOptions := &vpcv1.GetImageOptions{
+		ID: &imageID,
+	}
+	image, response, err := sess.GetImage(getImageOptions)
+	if err != nil {
+		// leave the error to the create call, which reports it with the request context
+		log.Printf("[DEBUG] Error getting image (%s) to validate the instance: %s\n%s", imageID, err, response)
+		return nil
+	}
+	if diff.HasChange(isInstanceImage) && image.Status != nil && *image.Status != "available" && *image.Status != "deprecated" {
+		return fmt.Errorf("[ERROR] Image (%s) is %s, an instance can only be provisioned from an available or deprecated image", imageID, *image.Status)
+	}
+
+	getInstanceProfileOptions := &vpcv1.GetInstanceProfileOptions{
+		Name: &profileName,
+	}
+	profile, response, err := sess.GetInstanceProfile(getInstanceProfileOptions)
+	if err != nil {
+		log.Printf("[DEBUG] Error getting instance profile (%s) to validate the instance: %s\n%s", profileName, err, response)
+		return nil
+	}
+	if image.OperatingSystem != nil && image.OperatingSystem.Architecture != nil && profile.VcpuArchitecture != nil && profile.VcpuArchitecture.Value != nil {
+		imageArch := *image.OperatingSystem.Architecture
+		profileArch := *profile.VcpuArchitecture.Value
+		if imageArch != profileArch {
+			return fmt.Errorf("[ERROR] Image (%s) has the %s architecture, which does not match the %s architecture of profile %s", imageID, imageArch, profileArch, profileName)
+		}
+	}
+	return nil
+}
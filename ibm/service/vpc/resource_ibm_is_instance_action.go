@@ -21,6 +21,7 @@ const (
 	isInstanceStopType        = "stop_type"
 	isInstanceID              = "instance"
 	isInstanceActionForce     = "force_action"
+	isInstanceActionTriggers  = "triggers"
 )
 
 func ResourceIBMISInstanceAction() *schema.Resource {
@@ -57,6 +58,12 @@ func ResourceIBMISInstanceAction() *schema.Resource {
 				Default:     false,
 				Description: "If set to true, the action will be forced immediately, and all queued actions deleted. Ignored for the start action.",
 			},
+			isInstanceActionTriggers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that run the action again when they change.",
+			},
 			isInstanceStatus: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -231,6 +238,10 @@ func resourceIBMISInstanceActionUpdate(context context.Context, d *schema.Resour
 		InstanceID: &id,
 		Type:       &actiontype,
 	}
+	if instanceActionForceIntf, ok := d.GetOk(isInstanceActionForce); ok {
+		force := instanceActionForceIntf.(bool)
+		createinsactoptions.Force = &force
+	}
 	_, response, err = sess.CreateInstanceAction(createinsactoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
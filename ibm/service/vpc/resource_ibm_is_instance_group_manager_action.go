@@ -47,12 +47,14 @@ func ResourceIBMISInstanceGroupManagerAction() *schema.Resource {
 			"instance_group": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "instance group ID",
 			},
 
 			"instance_group_manager": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Instance group manager ID of type scheduled",
 			},
 
@@ -101,6 +103,7 @@ func ResourceIBMISInstanceGroupManagerAction() *schema.Resource {
 			"target_manager": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				ForceNew:      true,
 				Description:   "The unique identifier for this instance group manager of type autoscale.",
 				ConflictsWith: []string{"membership_count"},
 				RequiredWith:  []string{"min_membership_count", "max_membership_count"},
@@ -324,21 +327,16 @@ func resourceIBMISInstanceGroupManagerActionUpdate(d *schema.ResourceData, meta
 		changed = true
 	}
 
-	instanceGroupManagerScheduledActionByManagerPatchManager := vpcv1.InstanceGroupManagerActionManagerPatch{}
-
-	if d.HasChange("min_membership_count") {
+	if _, ok := d.GetOk("target_manager"); ok && (d.HasChange("min_membership_count") || d.HasChange("max_membership_count")) {
+		instanceGroupManagerScheduledActionByManagerPatchManager := vpcv1.InstanceGroupManagerActionManagerPatch{}
 		minmembershipCount := int64(d.Get("min_membership_count").(int))
 		instanceGroupManagerScheduledActionByManagerPatchManager.MinMembershipCount = &minmembershipCount
+		maxmembershipCount := int64(d.Get("max_membership_count").(int))
+		instanceGroupManagerScheduledActionByManagerPatchManager.MaxMembershipCount = &maxmembershipCount
+		instanceGroupManagerActionPatchModel.Manager = &instanceGroupManagerScheduledActionByManagerPatchManager
 		changed = true
 	}
 
-	if d.HasChange("max_membership_count") {
-		minmembershipCount := int64(d.Get("max_membership_count").(int))
-		instanceGroupManagerScheduledActionByManagerPatchManager.MinMembershipCount = &minmembershipCount
-		changed = true
-	}
-	instanceGroupManagerActionPatchModel.Manager = &instanceGroupManagerScheduledActionByManagerPatchManager
-
 	if changed {
 
 		parts, err := flex.IdParts(d.Id())
@@ -370,7 +368,7 @@ func resourceIBMISInstanceGroupManagerActionUpdate(d *schema.ResourceData, meta
 			return fmt.Errorf("[ERROR] Error updating InstanceGroup manager action: %s\n%s", err, response)
 		}
 	}
-	return resourceIBMISInstanceGroupManagerRead(d, meta)
+	return resourceIBMISInstanceGroupManagerActionRead(d, meta)
 }
 
 func resourceIBMISInstanceGroupManagerActionRead(d *schema.ResourceData, meta interface{}) error {
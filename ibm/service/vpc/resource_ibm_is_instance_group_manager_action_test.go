@@ -70,12 +70,23 @@ func TestAccIBMISInstanceGroupManagerAction_basic_autoscale(t *testing.T) {
 		CheckDestroy: testAccCheckIBMISInstanceGroupManagerActionDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckIBMISInstanceGroupManagerActionAutoscaleConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, instanceGroupManagerAutoscale, instanceGroupManagerPolicyAction, instanceGroupManagerAction),
+				Config: testAccCheckIBMISInstanceGroupManagerActionAutoscaleConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, instanceGroupManagerAutoscale, instanceGroupManagerPolicyAction, instanceGroupManagerAction, 2),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"ibm_is_instance_group_manager.instance_group_manager", "name", instanceGroupManager),
 					resource.TestCheckResourceAttr(
 						"ibm_is_instance_group_manager_action.instance_group_manager_action", "name", instanceGroupManagerAction),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager_action.instance_group_manager_action", "max_membership_count", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceGroupManagerActionAutoscaleConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, instanceGroupManagerAutoscale, instanceGroupManagerPolicyAction, instanceGroupManagerAction, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager_action.instance_group_manager_action", "max_membership_count", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager_action.instance_group_manager_action", "min_membership_count", "1"),
 				),
 			},
 		},
@@ -175,7 +186,7 @@ func testAccCheckIBMISInstanceGroupManagerActionConfig(vpcName, subnetName, sshK
 
 }
 
-func testAccCheckIBMISInstanceGroupManagerActionAutoscaleConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, instanceGroupManagerAutoscale, instanceGroupManagerPolicyAction, instanceGroupManagerAction string) string {
+func testAccCheckIBMISInstanceGroupManagerActionAutoscaleConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, instanceGroupManagerAutoscale, instanceGroupManagerPolicyAction, instanceGroupManagerAction string, maxMembershipCount int) string {
 	return fmt.Sprintf(`
 	provider "ibm" {
 		generation = 2
@@ -252,9 +263,9 @@ func testAccCheckIBMISInstanceGroupManagerActionAutoscaleConfig(vpcName, subnetN
 		cron_spec              = "*/5 1,2,3 * * *"
 		target_manager         = ibm_is_instance_group_manager.instance_group_manager_autoscale.manager_id
 		min_membership_count   = 1
-		max_membership_count   = 2
+		max_membership_count   = %d
 	  }
 
-	`, vpcName, subnetName, sshKeyName, publicKey, templateName, acc.IsImage, instanceGroupName, instanceGroupManager, instanceGroupManagerAutoscale, instanceGroupManagerPolicyAction, instanceGroupManagerAction)
+	`, vpcName, subnetName, sshKeyName, publicKey, templateName, acc.IsImage, instanceGroupName, instanceGroupManager, instanceGroupManagerAutoscale, instanceGroupManagerPolicyAction, instanceGroupManagerAction, maxMembershipCount)
 
 }
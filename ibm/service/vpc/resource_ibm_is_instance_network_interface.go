@@ -350,7 +350,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	d.SetId(fmt.Sprintf("%s/%s", *createInstanceNetworkInterfaceOptions.InstanceID, *networkInterface.ID))
 	d.Set("network_interface", *networkInterface.ID)
 
-	_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 	}
@@ -370,7 +370,7 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 			d.Set(isInstanceNicFloatingIP, "")
 			return diag.FromErr(fmt.Errorf("[DEBUG] Error adding Floating IP to network interface %s\n%s", err, response))
 		}
-		_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 		}
@@ -685,7 +685,7 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 
 	}
 
-	_, err = isWaitForNetworkInterfaceAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 	}
@@ -723,7 +723,7 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 		return diag.FromErr(fmt.Errorf("DeleteInstanceNetworkInterfaceWithContext failed %s\n%s", err, response))
 	}
 
-	_, err = isWaitForNetworkInterfaceDelete(vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+	_, err = isWaitForNetworkInterfaceDelete(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error occured while waiting for network interface %s", err))
 	}
@@ -738,7 +738,7 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	return nil
 }
 
-func isWaitForNetworkInterfaceAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForNetworkInterfaceAvailable(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -750,7 +750,7 @@ func isWaitForNetworkInterfaceAvailable(vpcClient *vpcv1.VpcV1, id string, timeo
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -778,7 +778,7 @@ func isNetworkInterfaceRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.
 	}
 }
 
-func isWaitForNetworkInterfaceDelete(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForNetworkInterfaceDelete(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for dedicated host (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -790,7 +790,7 @@ func isWaitForNetworkInterfaceDelete(vpcClient *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isNetworkInterfaceRefreshDeleteFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
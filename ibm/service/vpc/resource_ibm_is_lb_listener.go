@@ -306,6 +306,9 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 
 	if app, ok := d.GetOk(isLBListenerAcceptProxyProtocol); ok {
 		acceptProxyProtocol := app.(bool)
+		if acceptProxyProtocol && !isLBProxyProtocolSupported(lb) {
+			return fmt.Errorf("[ERROR] accept_proxy_protocol is only supported by load balancers in the application family, load balancer (%s) is in the %s family", lbID, *lb.Profile.Family)
+		}
 		options.AcceptProxyProtocol = &acceptProxyProtocol
 	}
 
@@ -469,6 +472,12 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	return nil
 }
 
+// isLBProxyProtocolSupported reports whether the load balancer can accept and send PROXY protocol
+// headers, which only load balancers in the application family do.
+func isLBProxyProtocolSupported(lb *vpcv1.LoadBalancer) bool {
+	return lb.Profile == nil || lb.Profile.Family == nil || !strings.EqualFold(*lb.Profile.Family, "network")
+}
+
 func resourceIBMISLBListenerUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	parts, err := flex.IdParts(d.Id())
@@ -571,6 +580,15 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 
 	if d.HasChange(isLBListenerAcceptProxyProtocol) {
 		acceptProxyProtocol := d.Get(isLBListenerAcceptProxyProtocol).(bool)
+		if acceptProxyProtocol {
+			lb, response, err := sess.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: &lbID})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+			}
+			if !isLBProxyProtocolSupported(lb) {
+				return fmt.Errorf("[ERROR] accept_proxy_protocol is only supported by load balancers in the application family, load balancer (%s) is in the %s family", lbID, *lb.Profile.Family)
+			}
+		}
 		loadBalancerListenerPatchModel.AcceptProxyProtocol = &acceptProxyProtocol
 		hasChanged = true
 	}
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -98,6 +99,24 @@ func TestAccIBMISLBListener_basic_udp(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISLBListener_ProxyProtocolNetworkFamily(t *testing.T) {
+	vpcname := fmt.Sprintf("tflblis-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflblis-subnet-%d", acctest.RandIntRange(10, 100))
+	lbname := fmt.Sprintf("tflblis%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISLBListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISNLBProxyProtocolListenerConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, lbname, "8080", "tcp"),
+				ExpectError: regexp.MustCompile("only supported by load balancers in the application family"),
+			},
+		},
+	})
+}
+
 func TestAccIBMISNLBRouteModeListener_basic(t *testing.T) {
 	var lb string
 	vpcname := fmt.Sprintf("tflblis-vpc-%d", acctest.RandIntRange(10, 100))
@@ -364,6 +383,33 @@ func testAccCheckIBMISLBUdpListenerConfig(vpcname, subnetname, zone, cidr, lbnam
 
 }
 
+func testAccCheckIBMISNLBProxyProtocolListenerConfig(vpcname, subnetname, zone, cidr, lbname, port, protocol string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name 		= "%s"
+		vpc 		= "${ibm_is_vpc.testacc_vpc.id}"
+		zone 		= "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name 	= "%s"
+		subnets = ["${ibm_is_subnet.testacc_subnet.id}"]
+		profile = "network-fixed"
+		type 	= "public"
+	}
+	resource "ibm_is_lb_listener" "testacc_lb_listener" {
+		lb 			= "${ibm_is_lb.testacc_LB.id}"
+		port 		= %s
+		protocol 	= "%s"
+		accept_proxy_protocol = true
+    }`, vpcname, subnetname, zone, cidr, lbname, port, protocol)
+
+}
+
 func testAccCheckIBMISLBListenerHttpsRedirectConfig(vpcname, subnetname, zone, cidr, lbname, port, protocol string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
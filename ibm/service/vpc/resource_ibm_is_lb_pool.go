@@ -297,10 +297,13 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 		return err
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	lbi, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
+	if lb, ok := lbi.(*vpcv1.LoadBalancer); ok && pProtocol != "" && pProtocol != "disabled" && !isLBProxyProtocolSupported(lb) {
+		return fmt.Errorf("[ERROR] proxy_protocol %s is only supported by load balancers in the application family, load balancer (%s) is in the %s family", pProtocol, lbID, *lb.Profile.Family)
+	}
 
 	options := &vpcv1.CreateLoadBalancerPoolOptions{
 		LoadBalancerID: &lbID,
@@ -515,6 +518,15 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 
 	if d.HasChange(isLBPoolProxyProtocol) {
 		proxyProtocol := d.Get(isLBPoolProxyProtocol).(string)
+		if proxyProtocol != "disabled" {
+			lb, response, err := sess.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: &lbID})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error getting Load Balancer : %s\n%s", err, response)
+			}
+			if !isLBProxyProtocolSupported(lb) {
+				return fmt.Errorf("[ERROR] proxy_protocol %s is only supported by load balancers in the application family, load balancer (%s) is in the %s family", proxyProtocol, lbID, *lb.Profile.Family)
+			}
+		}
 		loadBalancerPoolPatchModel.ProxyProtocol = &proxyProtocol
 		hasChanged = true
 	}
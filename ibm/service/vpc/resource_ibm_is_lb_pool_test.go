@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -401,6 +402,24 @@ func TestAccIBMISLBPool_SessionPersistence(t *testing.T) {
 	})
 }
 
+func TestAccIBMISLBPool_SessionPersistenceProtocol(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbp-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbp-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISLBPoolSessionPersistenceConfigUpdate(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, "round_robin", "tcp", "45", "5", "15", "tcp", "2554", "http_cookie"),
+				ExpectError: regexp.MustCompile("is only applicable for protocol 'http' or 'https'"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISLBPoolDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
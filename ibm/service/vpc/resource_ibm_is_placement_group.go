@@ -189,7 +189,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 
 	d.SetId(*placementGroup.ID)
 
-	_, err = isWaitForPlacementGroupAvailable(vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForPlacementGroupAvailable(context, vpcClient, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for placement group to be available %s", err))
 	}
@@ -342,7 +342,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 	response, err := vpcClient.DeletePlacementGroupWithContext(context, deletePlacementGroupOptions)
 	if err != nil {
 		if response.StatusCode == 409 {
-			_, err = isWaitForPlacementGroupDeleteRetry(vpcClient, d, d.Id())
+			_, err = isWaitForPlacementGroupDeleteRetry(context, vpcClient, d, d.Id())
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s", err))
 			}
@@ -350,7 +350,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting PLacementGroup: %s\n%s", err, response))
 		}
 	}
-	_, err = isWaitForPlacementGroupDelete(vpcClient, d, d.Id())
+	_, err = isWaitForPlacementGroupDelete(context, vpcClient, d, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -359,7 +359,7 @@ func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.Resour
 	return nil
 }
 
-func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForPlacementGroupDelete(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isPlacementGroupDeleting, isPlacementGroupStable, isPlacementGroupPending, isPlacementGroupWaiting, isPlacementGroupUpdating},
@@ -388,10 +388,10 @@ func isWaitForPlacementGroupDelete(vpcClient *vpcv1.VpcV1, d *schema.ResourceDat
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForPlacementGroupDeleteRetry(context context.Context, vpcClient *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isPlacementGroupResourcesAttached},
@@ -417,10 +417,10 @@ func isWaitForPlacementGroupDeleteRetry(vpcClient *vpcv1.VpcV1, d *schema.Resour
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
-func isWaitForPlacementGroupAvailable(vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForPlacementGroupAvailable(context context.Context, vpcClient *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for placement group (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -432,7 +432,7 @@ func isWaitForPlacementGroupAvailable(vpcClient *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(context)
 }
 
 func isPlacementGroupRefreshFunc(vpcClient *vpcv1.VpcV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMISPublicGatewaySubnetAttachments() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISPublicGatewaySubnetAttachmentsCreate,
+		ReadContext:   resourceIBMISPublicGatewaySubnetAttachmentsRead,
+		UpdateContext: resourceIBMISPublicGatewaySubnetAttachmentsUpdate,
+		DeleteContext: resourceIBMISPublicGatewaySubnetAttachmentsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isPublicGatewayID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the public gateway",
+			},
+
+			isPublicGatewaySubnets: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The subnets to attach to the public gateway. Subnets attached to the public gateway that are not in the list are detached",
+			},
+		},
+	}
+}
+
+func resourceIBMISPublicGatewaySubnetAttachmentsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	publicGateway := d.Get(isPublicGatewayID).(string)
+	attached, err := isPublicGatewayAttachedSubnets(context, sess, publicGateway)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	attachedIDs := make([]string, 0, len(attached))
+	for _, subnet := range attached {
+		attachedIDs = append(attachedIDs, *subnet.ID)
+	}
+
+	d.SetId(publicGateway)
+	err = isPublicGatewaySubnetAttachmentsApply(context, sess, publicGateway, attachedIDs, flex.ExpandStringList(d.Get(isPublicGatewaySubnets).(*schema.Set).List()), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMISPublicGatewaySubnetAttachmentsRead(context, d, meta)
+}
+
+func resourceIBMISPublicGatewaySubnetAttachmentsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	publicGateway := d.Id()
+	getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
+		ID: &publicGateway,
+	}
+	_, response, err := sess.GetPublicGatewayWithContext(context, getPublicGatewayOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting public gateway (%s): %s\n%s", publicGateway, err, response))
+	}
+
+	attached, err := isPublicGatewayAttachedSubnets(context, sess, publicGateway)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	subnets := make([]string, 0, len(attached))
+	for _, subnet := range attached {
+		subnets = append(subnets, *subnet.ID)
+	}
+
+	d.Set(isPublicGatewayID, publicGateway)
+	if err = d.Set(isPublicGatewaySubnets, subnets); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subnets: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMISPublicGatewaySubnetAttachmentsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(isPublicGatewaySubnets) {
+		oldSubnets, newSubnets := d.GetChange(isPublicGatewaySubnets)
+		err = isPublicGatewaySubnetAttachmentsApply(context, sess, d.Id(), flex.ExpandStringList(oldSubnets.(*schema.Set).List()), flex.ExpandStringList(newSubnets.(*schema.Set).List()), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMISPublicGatewaySubnetAttachmentsRead(context, d, meta)
+}
+
+func resourceIBMISPublicGatewaySubnetAttachmentsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only the subnets that are still attached to this public gateway are detached.
+	attached, err := isPublicGatewayAttachedSubnets(context, sess, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	managed := map[string]bool{}
+	for _, subnet := range flex.ExpandStringList(d.Get(isPublicGatewaySubnets).(*schema.Set).List()) {
+		managed[subnet] = true
+	}
+	detach := []string{}
+	for _, subnet := range attached {
+		if managed[*subnet.ID] {
+			detach = append(detach, *subnet.ID)
+		}
+	}
+
+	err = isPublicGatewaySubnetAttachmentsApply(context, sess, d.Id(), detach, []string{}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// isPublicGatewaySubnetAttachmentsApply detaches the subnets that are in attached but not in
+// wanted, then attaches the subnets that are in wanted but not in attached.
+func isPublicGatewaySubnetAttachmentsApply(context context.Context, sess *vpcv1.VpcV1, publicGateway string, attached, wanted []string, timeout time.Duration) error {
+	attachedSet := map[string]bool{}
+	for _, subnet := range attached {
+		attachedSet[subnet] = true
+	}
+	wantedSet := map[string]bool{}
+	for _, subnet := range wanted {
+		wantedSet[subnet] = true
+	}
+
+	for _, subnet := range attached {
+		if wantedSet[subnet] {
+			continue
+		}
+		subnetID := subnet
+		unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
+			ID: &subnetID,
+		}
+		response, err := sess.UnsetSubnetPublicGatewayWithContext(context, unsetSubnetPublicGatewayOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			log.Printf("[DEBUG] Error while detaching public gateway(%s) from subnet(%s) %s\n%s", publicGateway, subnetID, err, response)
+			return fmt.Errorf("[ERROR] Error while detaching public gateway(%s) from subnet(%s) %s\n%s", publicGateway, subnetID, err, response)
+		}
+		_, err = isWaitForSubnetPublicGatewayDelete(context, sess, subnetID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, subnet := range wanted {
+		if attachedSet[subnet] {
+			continue
+		}
+		subnetID := subnet
+		setSubnetPublicGatewayOptions := &vpcv1.SetSubnetPublicGatewayOptions{
+			ID: &subnetID,
+			PublicGatewayIdentity: &vpcv1.PublicGatewayIdentity{
+				ID: &publicGateway,
+			},
+		}
+		_, response, err := sess.SetSubnetPublicGatewayWithContext(context, setSubnetPublicGatewayOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Error while attaching public gateway(%s) to subnet(%s) %s\n%s", publicGateway, subnetID, err, response)
+			return fmt.Errorf("[ERROR] Error while attaching public gateway(%s) to subnet(%s) %s\n%s", publicGateway, subnetID, err, response)
+		}
+		_, err = isWaitForSubnetPublicGatewayAvailable(context, sess, subnetID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
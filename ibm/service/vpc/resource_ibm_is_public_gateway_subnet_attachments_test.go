@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISPublicGatewaySubnetAttachments_basic(t *testing.T) {
+	pgname := fmt.Sprintf("tfnw-pg-%d", acctest.RandIntRange(10, 100))
+	vpcname := fmt.Sprintf("tfsubnet-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsubnet-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: checkPublicGatewaySubnetAttachmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISPublicGatewaySubnetAttachmentsConfig(vpcname, name, acc.ISZoneName, pgname, "[ibm_is_subnet.testacc_subnet[0].id, ibm_is_subnet.testacc_subnet[1].id]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_public_gateway_subnet_attachments.attach", "subnets.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_is_public_gateway_subnets.shared", "subnets.#", "2"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_public_gateway_subnets.shared", "zone", "ibm_is_public_gateway.testacc_pg", "zone"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISPublicGatewaySubnetAttachmentsConfig(vpcname, name, acc.ISZoneName, pgname, "[ibm_is_subnet.testacc_subnet[1].id]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_public_gateway_subnet_attachments.attach", "subnets.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_public_gateway_subnets.shared", "subnets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_public_gateway_subnets.shared", "subnets.0.id", "ibm_is_subnet.testacc_subnet.1", "id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_public_gateway_subnet_attachments.attach",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkPublicGatewaySubnetAttachmentsDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_subnet" {
+			continue
+		}
+		getSubnetPublicGatewayOptionsModel := &vpcv1.GetSubnetPublicGatewayOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetSubnetPublicGateway(getSubnetPublicGatewayOptionsModel)
+
+		if err == nil {
+			return fmt.Errorf("subnet public gateway attachment still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISPublicGatewaySubnetAttachmentsConfig(vpcname, name, zone, pgname, subnets string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		count 						= 2
+		name 						= "%s-${count.index}"
+		vpc 						= ibm_is_vpc.testacc_vpc.id
+		zone 						= "%s"
+		total_ipv4_address_count 	= 16
+	}
+
+	resource "ibm_is_public_gateway" "testacc_pg" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+	}
+
+	resource "ibm_is_public_gateway_subnet_attachments" "attach" {
+		public_gateway 	= ibm_is_public_gateway.testacc_pg.id
+		subnets      	= %s
+	}
+
+	data "ibm_is_public_gateway_subnets" "shared" {
+		public_gateway 	= ibm_is_public_gateway_subnet_attachments.attach.public_gateway
+		depends_on		= [ibm_is_public_gateway_subnet_attachments.attach]
+	}
+	`, vpcname, name, zone, pgname, zone, subnets)
+}
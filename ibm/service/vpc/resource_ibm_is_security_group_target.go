@@ -9,8 +9,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,6 +21,9 @@ import (
 const (
 	isSecurityGroupTargetID     = "target"
 	isSecurityGroupResourceType = "resource_type"
+
+	isSecurityGroupTargetPending  = "pending"
+	isSecurityGroupTargetAttached = "attached"
 )
 
 func ResourceIBMISSecurityGroupTarget() *schema.Resource {
@@ -64,9 +69,12 @@ func ResourceIBMISSecurityGroupTarget() *schema.Resource {
 			},
 
 			isSecurityGroupResourceType: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Resource Type",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_target", isSecurityGroupResourceType),
+				Description:  "Resource Type. When set, the target is verified to be of this type before it is attached",
 			},
 		},
 	}
@@ -91,7 +99,13 @@ func ResourceIBMISSecurityGroupTargetValidator() *validate.ResourceValidator {
 			Required:                   true,
 			Regexp:                     `^[-0-9a-z_]+$`,
 			MinValueLength:             1,
-			MaxValueLength:             64})
+			MaxValueLength:             64},
+		validate.ValidateSchema{
+			Identifier:                 isSecurityGroupResourceType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "endpoint_gateway, load_balancer, network_interface, vpn_server"})
 
 	ibmISSecurityGroupResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_security_group_target", Schema: validateSchema}
 	return &ibmISSecurityGroupResourceValidator
@@ -107,16 +121,45 @@ func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interfa
 	securityGroupID := d.Get("security_group").(string)
 	targetID := d.Get(isSecurityGroupTargetID).(string)
 
+	if targetType, ok := d.GetOk(isSecurityGroupResourceType); ok {
+		err = isSecurityGroupTargetCheckType(sess, targetID, targetType.(string))
+		if err != nil {
+			return err
+		}
+	}
+
 	createSecurityGroupTargetBindingOptions := &vpcv1.CreateSecurityGroupTargetBindingOptions{}
 	createSecurityGroupTargetBindingOptions.SecurityGroupID = &securityGroupID
 	createSecurityGroupTargetBindingOptions.ID = &targetID
 
-	sg, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
-	if err != nil || sg == nil {
-		return fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response)
+	var sg vpcv1.SecurityGroupTargetReferenceIntf
+	targetType := d.Get(isSecurityGroupResourceType).(string)
+	// The target may still be provisioning or updating from a previous binding, retry until it accepts the binding.
+	// Other conflicts, such as a target that already has the maximum number of security groups, are not retried.
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var response *core.DetailedResponse
+		var err error
+		sg, response, err = sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
+		if err != nil || sg == nil {
+			if response != nil && response.StatusCode == 409 && isSecurityGroupTargetBusy(sess, targetID, targetType) {
+				return resource.RetryableError(fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response))
+			}
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] Error while creating Security Group Target Binding %s\n%s", err, response))
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		sg, _, err = sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
+	}
+	if err != nil {
+		return err
 	}
 	sgtarget := sg.(*vpcv1.SecurityGroupTargetReference)
 	d.SetId(fmt.Sprintf("%s/%s", securityGroupID, *sgtarget.ID))
+	_, err = isWaitForSecurityGroupTargetAttached(sess, securityGroupID, *sgtarget.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
 	crn := sgtarget.CRN
 	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
 		lbid := sgtarget.ID
@@ -325,3 +368,95 @@ func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefre
 		return lb, isLBProvisioning, nil
 	}
 }
+
+// isSecurityGroupTargetCheckType verifies that targetID identifies a resource of targetType, network interfaces
+// cannot be looked up without their instance and are left to the API to validate
+func isSecurityGroupTargetCheckType(sess *vpcv1.VpcV1, targetID, targetType string) error {
+	var response *core.DetailedResponse
+	var err error
+	switch targetType {
+	case "load_balancer":
+		_, response, err = sess.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: &targetID})
+	case "endpoint_gateway":
+		_, response, err = sess.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{ID: &targetID})
+	case "vpn_server":
+		_, response, err = sess.GetVPNServer(&vpcv1.GetVPNServerOptions{ID: &targetID})
+	default:
+		return nil
+	}
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return fmt.Errorf("[ERROR] Security group target %s is not a %s", targetID, targetType)
+		}
+		return fmt.Errorf("[ERROR] Error getting %s %s: %s\n%s", targetType, targetID, err, response)
+	}
+	return nil
+}
+
+// isSecurityGroupTargetBusy reports whether the target is a load balancer, endpoint gateway or VPN server that is
+// still being provisioned or updated. When targetType is empty every type is tried.
+func isSecurityGroupTargetBusy(sess *vpcv1.VpcV1, targetID, targetType string) bool {
+	if targetType == "" || targetType == "load_balancer" {
+		lb, _, err := sess.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: &targetID})
+		if err == nil && lb.ProvisioningStatus != nil {
+			switch *lb.ProvisioningStatus {
+			case vpcv1.LoadBalancerProvisioningStatusCreatePendingConst, vpcv1.LoadBalancerProvisioningStatusUpdatePendingConst, vpcv1.LoadBalancerProvisioningStatusMaintenancePendingConst:
+				return true
+			}
+			return false
+		}
+	}
+	if targetType == "" || targetType == "endpoint_gateway" {
+		endpointGateway, _, err := sess.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{ID: &targetID})
+		if err == nil && endpointGateway.LifecycleState != nil {
+			switch *endpointGateway.LifecycleState {
+			case vpcv1.EndpointGatewayLifecycleStatePendingConst, vpcv1.EndpointGatewayLifecycleStateUpdatingConst, vpcv1.EndpointGatewayLifecycleStateWaitingConst:
+				return true
+			}
+			return false
+		}
+	}
+	if targetType == "" || targetType == "vpn_server" {
+		vpnServer, _, err := sess.GetVPNServer(&vpcv1.GetVPNServerOptions{ID: &targetID})
+		if err == nil && vpnServer.LifecycleState != nil {
+			switch *vpnServer.LifecycleState {
+			case vpcv1.VPNServerLifecycleStatePendingConst, vpcv1.VPNServerLifecycleStateUpdatingConst, vpcv1.VPNServerLifecycleStateWaitingConst:
+				return true
+			}
+			return false
+		}
+	}
+	return false
+}
+
+func isWaitForSecurityGroupTargetAttached(sess *vpcv1.VpcV1, securityGroupID, securityGroupTargetID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("[INFO] Waiting for security group target (%s) to be attached.", securityGroupTargetID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isSecurityGroupTargetPending},
+		Target:     []string{isSecurityGroupTargetAttached},
+		Refresh:    isSecurityGroupTargetAttachedRefreshFunc(sess, securityGroupID, securityGroupTargetID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isSecurityGroupTargetAttachedRefreshFunc(sess *vpcv1.VpcV1, securityGroupID, securityGroupTargetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getSecurityGroupTargetOptions := &vpcv1.GetSecurityGroupTargetOptions{
+			SecurityGroupID: &securityGroupID,
+			ID:              &securityGroupTargetID,
+		}
+		sgt, response, err := sess.GetSecurityGroupTarget(getSecurityGroupTargetOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return response, isSecurityGroupTargetPending, nil
+			}
+			return nil, "", fmt.Errorf("[ERROR] Error getting Security Group Target : %s\n%s", err, response)
+		}
+		return sgt, isSecurityGroupTargetAttached, nil
+	}
+}
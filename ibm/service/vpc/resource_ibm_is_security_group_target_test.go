@@ -36,6 +36,8 @@ func TestAccIBMISSecurityGroupTarget_basic(t *testing.T) {
 					testAccCheckIBMISSecurityGroupTargetExists("ibm_is_security_group_target.testacc_security_group_target", &securityGroup),
 					resource.TestCheckResourceAttr(
 						"ibm_is_security_group_target.testacc_security_group_target", "name", lbname),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_target.testacc_security_group_target", "resource_type", "load_balancer"),
 				),
 			},
 		},
@@ -140,6 +142,7 @@ resource "ibm_is_lb" "testacc_LB" {
 resource "ibm_is_security_group_target" "testacc_security_group_target" {
     security_group = ibm_is_security_group.testacc_security_group_one.id
     target = ibm_is_lb.testacc_LB.id
+    resource_type = "load_balancer"
   }`, vpcname, subnetname, zoneName, cidr, name, lbname)
 
 }
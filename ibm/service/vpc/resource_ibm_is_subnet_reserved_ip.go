@@ -4,9 +4,12 @@
 package vpc
 
 import (
+	"encoding/binary"
 	"fmt"
 	"log"
+	"net"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -23,6 +26,7 @@ const (
 	isReservedIP                 = "reserved_ip"
 	isReservedIPTarget           = "target"
 	isReservedIPLifecycleState   = "lifecycle_state"
+	isReservedIPAddressPool      = "address_pool"
 )
 
 func ResourceIBMISReservedIP() *schema.Resource {
@@ -82,11 +86,20 @@ func ResourceIBMISReservedIP() *schema.Resource {
 			*/
 
 			isReservedIPAddress: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Computed:    true,
-				Description: "The address for this reserved IP.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{isReservedIPAddressPool},
+				Description:   "The address for this reserved IP.",
+			},
+			isReservedIPAddressPool: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isReservedIPAddress},
+				ValidateFunc:  validateReservedIPAddressPool,
+				Description:   "The addresses to pick the address from, a CIDR block or a range first-last in the subnet. The lowest free address is reserved.",
 			},
 			isReservedIP: {
 				Type:        schema.TypeString,
@@ -166,9 +179,18 @@ func resourceIBMISReservedIPCreate(d *schema.ResourceData, meta interface{}) err
 			ID: &targetId,
 		}
 	}
-	rip, response, err := sess.CreateSubnetReservedIP(options)
-	if err != nil || response == nil || rip == nil {
-		return fmt.Errorf("[ERROR] Error creating the reserved IP: %s\n%s", err, response)
+	var rip *vpcv1.ReservedIP
+	if pool, ok := d.GetOk(isReservedIPAddressPool); ok {
+		rip, err = isReservedIPCreateInPool(sess, options, pool.(string))
+		if err != nil {
+			return err
+		}
+	} else {
+		var response *core.DetailedResponse
+		rip, response, err = sess.CreateSubnetReservedIP(options)
+		if err != nil || response == nil || rip == nil {
+			return fmt.Errorf("[ERROR] Error creating the reserved IP: %s\n%s", err, response)
+		}
 	}
 
 	// Set id for the reserved IP as combination of subnet ID and reserved IP ID
@@ -390,3 +412,112 @@ func isReserveIpRefreshFunc(sess *vpcv1.VpcV1, subnetid, id string, d *schema.Re
 		return rsip, "pending", nil
 	}
 }
+
+// isReservedIPCreateInPool creates the reserved IP with the lowest address of the pool that is not reserved yet.
+// Reserved IPs created in parallel can pick the same address, the ones that lose move on to the next address.
+func isReservedIPCreateInPool(sess *vpcv1.VpcV1, options *vpcv1.CreateSubnetReservedIPOptions, pool string) (*vpcv1.ReservedIP, error) {
+	first, last, err := reservedIPAddressPoolRange(pool)
+	if err != nil {
+		return nil, err
+	}
+	subnetID := *options.SubnetID
+	subnet, response, err := sess.GetSubnet(&vpcv1.GetSubnetOptions{ID: &subnetID})
+	if err != nil || subnet == nil {
+		return nil, fmt.Errorf("[ERROR] Error getting subnet %s: %s\n%s", subnetID, err, response)
+	}
+	_, network, err := net.ParseCIDR(*subnet.Ipv4CIDRBlock)
+	if err != nil {
+		return nil, err
+	}
+	if !network.Contains(reservedIPAddress(first)) || !network.Contains(reservedIPAddress(last)) {
+		return nil, fmt.Errorf("[ERROR] The address pool %s is not in the range %s of subnet %s", pool, *subnet.Ipv4CIDRBlock, subnetID)
+	}
+
+	reserved, err := isReservedIPSubnetAddresses(sess, subnetID)
+	if err != nil {
+		return nil, err
+	}
+	for ip := uint64(first); ip <= uint64(last); ip++ {
+		address := reservedIPAddress(uint32(ip)).String()
+		if reserved[address] {
+			continue
+		}
+		options.Address = &address
+		rip, response, err := sess.CreateSubnetReservedIP(options)
+		if err == nil && rip != nil {
+			return rip, nil
+		}
+		// The create only fails for a taken address if another reserved IP got it in the meantime.
+		var listErr error
+		reserved, listErr = isReservedIPSubnetAddresses(sess, subnetID)
+		if listErr != nil {
+			return nil, listErr
+		}
+		if !reserved[address] {
+			return nil, fmt.Errorf("[ERROR] Error creating the reserved IP: %s\n%s", err, response)
+		}
+		log.Printf("[DEBUG] Address %s of pool %s was reserved in the meantime, trying the next one", address, pool)
+	}
+	return nil, fmt.Errorf("[ERROR] The address pool %s of subnet %s has no free address", pool, subnetID)
+}
+
+// isReservedIPSubnetAddresses returns the addresses of all the reserved IPs of a subnet, including the ones of the provider.
+func isReservedIPSubnetAddresses(sess *vpcv1.VpcV1, subnetID string) (map[string]bool, error) {
+	addresses := map[string]bool{}
+	start := ""
+	for {
+		options := &vpcv1.ListSubnetReservedIpsOptions{SubnetID: &subnetID}
+		if start != "" {
+			options.Start = &start
+		}
+		result, response, err := sess.ListSubnetReservedIps(options)
+		if err != nil || response == nil || result == nil {
+			return nil, fmt.Errorf("[ERROR] Error fetching reserved ips %s\n%s", err, response)
+		}
+		for _, rip := range result.ReservedIps {
+			addresses[*rip.Address] = true
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+	return addresses, nil
+}
+
+func validateReservedIPAddressPool(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := reservedIPAddressPoolRange(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// reservedIPAddressPoolRange returns the first and the last address of a pool, given as a CIDR block or as first-last.
+func reservedIPAddressPoolRange(pool string) (uint32, uint32, error) {
+	if strings.Contains(pool, "/") {
+		_, network, err := net.ParseCIDR(pool)
+		if err != nil || network.IP.To4() == nil {
+			return 0, 0, fmt.Errorf("%s is not a valid IPv4 CIDR block", pool)
+		}
+		first := binary.BigEndian.Uint32(network.IP.To4())
+		return first, first | ^binary.BigEndian.Uint32(network.Mask), nil
+	}
+	bounds := strings.Split(pool, "-")
+	if len(bounds) != 2 {
+		return 0, 0, fmt.Errorf("%s must be a CIDR block or a range first-last", pool)
+	}
+	first, last := net.ParseIP(strings.TrimSpace(bounds[0])).To4(), net.ParseIP(strings.TrimSpace(bounds[1])).To4()
+	if first == nil || last == nil {
+		return 0, 0, fmt.Errorf("%s is not a valid IPv4 range", pool)
+	}
+	if binary.BigEndian.Uint32(first) > binary.BigEndian.Uint32(last) {
+		return 0, 0, fmt.Errorf("the first address of %s is after the last one", pool)
+	}
+	return binary.BigEndian.Uint32(first), binary.BigEndian.Uint32(last), nil
+}
+
+func reservedIPAddress(ip uint32) net.IP {
+	address := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(address, ip)
+	return address
+}
@@ -71,6 +71,27 @@ func TestAccIBMISSubnetReservedIPResource_address(t *testing.T) {
 	})
 }
 
+func TestAccIBMISSubnetReservedIPResource_addressPool(t *testing.T) {
+	var reservedIPID string
+	vpcName := fmt.Sprintf("tfresip-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetName := fmt.Sprintf("tfresip-subnet-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckisSubnetReservedIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckISSubnetReservedIPConfigAddressPool(vpcName, subnetName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckISSubnetReservedIPExists("ibm_is_subnet_reserved_ip.resIP1", &reservedIPID),
+					resource.TestCheckResourceAttr("ibm_is_subnet_reserved_ip.resIP1", "address", "10.240.0.16"),
+					resource.TestCheckResourceAttr("ibm_is_subnet_reserved_ip.resIP2", "address", "10.240.0.17"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckisSubnetReservedIPDestroy(s *terraform.State) error {
 	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	if err != nil {
@@ -174,3 +195,28 @@ func testAccCheckISSubnetReservedIPConfigAddress(vpcName, subnetName, resIPName
 	  }
 	`, vpcName, subnetName, acc.ISZoneName, acc.ISCIDR, resIPName)
 }
+
+func testAccCheckISSubnetReservedIPConfigAddressPool(vpcName, subnetName string) string {
+	return fmt.Sprintf(`
+	  resource "ibm_is_vpc" "vpc1" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "subnet1" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.vpc1.id
+		zone 					 = "%s"
+		ipv4_cidr_block 		 = "%s"
+	  }
+
+	  resource "ibm_is_subnet_reserved_ip" "resIP1" {
+		subnet 		 = ibm_is_subnet.subnet1.id
+		address_pool = "${replace(ibm_is_subnet.subnet1.ipv4_cidr_block, "0/24", "16")}-${replace(ibm_is_subnet.subnet1.ipv4_cidr_block, "0/24", "20")}"
+	  }
+
+	  resource "ibm_is_subnet_reserved_ip" "resIP2" {
+		subnet 		 = ibm_is_subnet.subnet1.id
+		address_pool = ibm_is_subnet_reserved_ip.resIP1.address_pool
+	  }
+	`, vpcName, subnetName, acc.ISZoneName, acc.ISCIDR)
+}
@@ -41,6 +41,8 @@ const (
 	isVolumeResourceGroup         = "resource_group"
 	isVolumeSourceSnapshot        = "source_snapshot"
 	isVolumeDeleteAllSnapshots    = "delete_all_snapshots"
+	isVolumeForceDetachOnDelete   = "force_detach_on_delete"
+	isVolumeStopInstanceToDetach  = "stop_instance_to_detach"
 	isVolumeBandwidth             = "bandwidth"
 	isVolumeAccessTags            = "access_tags"
 	isVolumeUserTagType           = "user"
@@ -220,6 +222,18 @@ func ResourceIBMISVolume() *schema.Resource {
 				Optional:    true,
 				Description: "Deletes all snapshots created from this volume",
 			},
+			isVolumeForceDetachOnDelete: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Detaches the volume from the instances it is attached to before deleting it. If false, deleting an attached volume fails and lists the attachments",
+			},
+			isVolumeStopInstanceToDetach: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Stops an instance that rejects the detach of the volume on delete, and starts it again once the volume is detached",
+			},
 			isVolumeTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -851,21 +865,10 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		return fmt.Errorf("[ERROR] Error getting Volume (%s): %s\n%s", id, err, response)
 	}
 
-	if volDetails.VolumeAttachments != nil {
-		for _, volAtt := range volDetails.VolumeAttachments {
-			deleteVolumeAttachment := &vpcv1.DeleteInstanceVolumeAttachmentOptions{
-				InstanceID: volAtt.Instance.ID,
-				ID:         volAtt.ID,
-			}
-			_, err := sess.DeleteInstanceVolumeAttachment(deleteVolumeAttachment)
-			if err != nil {
-				return fmt.Errorf("[ERROR] Error while removing volume attachment %q for instance %s: %q", *volAtt.ID, *volAtt.Instance.ID, err)
-			}
-			_, err = isWaitForInstanceVolumeDetached(sess, d, d.Id(), *volAtt.ID)
-			if err != nil {
-				return err
-			}
-
+	if len(volDetails.VolumeAttachments) > 0 {
+		err = volDetach(d, sess, id, volDetails.VolumeAttachments)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -884,6 +887,90 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
+// volDetach removes the attachments of the volume before it is deleted. Boot attachments cannot be
+// removed, and nothing is removed unless force_detach_on_delete is set, in both cases the error lists
+// the attachments that block the delete.
+func volDetach(d *schema.ResourceData, sess *vpcv1.VpcV1, id string, attachments []vpcv1.VolumeAttachmentReferenceVolumeContext) error {
+	blocking := []string{}
+	for _, volAtt := range attachments {
+		if *volAtt.Type == "boot" {
+			blocking = append(blocking, fmt.Sprintf("boot attachment %s of instance %s (%s)", *volAtt.Name, *volAtt.Instance.Name, *volAtt.Instance.ID))
+		}
+	}
+	if len(blocking) > 0 {
+		return fmt.Errorf("[ERROR] Volume (%s) cannot be deleted, a boot volume cannot be detached: %s. Delete the instance instead", id, strings.Join(blocking, ", "))
+	}
+	if !d.Get(isVolumeForceDetachOnDelete).(bool) {
+		for _, volAtt := range attachments {
+			blocking = append(blocking, fmt.Sprintf("attachment %s of instance %s (%s)", *volAtt.Name, *volAtt.Instance.Name, *volAtt.Instance.ID))
+		}
+		return fmt.Errorf("[ERROR] Volume (%s) cannot be deleted while it is attached: %s. Remove the attachments or set %s", id, strings.Join(blocking, ", "), isVolumeForceDetachOnDelete)
+	}
+
+	for _, volAtt := range attachments {
+		instanceID := *volAtt.Instance.ID
+		deleteVolumeAttachment := &vpcv1.DeleteInstanceVolumeAttachmentOptions{
+			InstanceID: &instanceID,
+			ID:         volAtt.ID,
+		}
+		response, err := sess.DeleteInstanceVolumeAttachment(deleteVolumeAttachment)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			instance, _, getErr := sess.GetInstance(&vpcv1.GetInstanceOptions{ID: &instanceID})
+			if getErr != nil || !d.Get(isVolumeStopInstanceToDetach).(bool) || *instance.Status != isInstanceStatusRunning {
+				status := "unknown"
+				if getErr == nil {
+					status = *instance.Status
+				}
+				return fmt.Errorf("[ERROR] Error while removing volume attachment %q for instance %s (status %s): %q. Stop the instance, or set %s to let the provider stop it", *volAtt.ID, instanceID, status, err, isVolumeStopInstanceToDetach)
+			}
+
+			log.Printf("[INFO] Stopping instance (%s) to detach volume (%s)", instanceID, id)
+			stop := "stop"
+			_, response, err = sess.CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{
+				InstanceID: &instanceID,
+				Type:       &stop,
+			})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error stopping instance (%s) to detach volume (%s): %s\n%s", instanceID, id, err, response)
+			}
+			_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutDelete), instanceID, d)
+			if err != nil {
+				return err
+			}
+
+			response, err = sess.DeleteInstanceVolumeAttachment(deleteVolumeAttachment)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return fmt.Errorf("[ERROR] Error while removing volume attachment %q for stopped instance %s: %q", *volAtt.ID, instanceID, err)
+			}
+			_, err = isWaitForInstanceVolumeDetached(sess, d, instanceID, *volAtt.ID)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] Starting instance (%s) again after detaching volume (%s)", instanceID, id)
+			start := "start"
+			_, response, err = sess.CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{
+				InstanceID: &instanceID,
+				Type:       &start,
+			})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error starting instance (%s) after detaching volume (%s): %s\n%s", instanceID, id, err, response)
+			}
+			_, err = isWaitForInstanceActionStart(sess, d.Timeout(schema.TimeoutDelete), instanceID, d)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		_, err = isWaitForInstanceVolumeDetached(sess, d, instanceID, *volAtt.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
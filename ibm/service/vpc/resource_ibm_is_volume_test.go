@@ -49,6 +49,39 @@ func TestAccIBMISVolume_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVolume_forceDetachOnDelete(t *testing.T) {
+	var vol string
+	name := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVolumeConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVolumeExists("ibm_is_volume.storage", vol),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "force_detach_on_delete", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "stop_instance_to_detach", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVolumeForceDetachConfig(name, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVolumeExists("ibm_is_volume.storage", vol),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "force_detach_on_delete", "false"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "stop_instance_to_detach", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISVolume_snapshot(t *testing.T) {
 	var vol string
 	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
@@ -434,6 +467,20 @@ func testAccCheckIBMISVolumeTierConfig(vpcname, subnetname, sshname, publicKey,
 
 }
 
+func testAccCheckIBMISVolumeForceDetachConfig(name string, forceDetach, stopInstance bool) string {
+	return fmt.Sprintf(
+		`
+	resource "ibm_is_volume" "storage"{
+		name = "%s"
+		profile = "10iops-tier"
+		zone = "us-south-1"
+		force_detach_on_delete = %t
+		stop_instance_to_detach = %t
+	}
+`, name, forceDetach, stopInstance)
+
+}
+
 func testAccCheckIBMISVolumeAttachmentDeleteConfig(vpcname, subnetname, sshname, publicKey, insname, capacityArray string) string {
 	return fmt.Sprintf(
 		`
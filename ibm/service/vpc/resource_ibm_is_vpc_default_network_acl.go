@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMISVPCDefaultNetworkACL() *schema.Resource {
+	resource := ResourceIBMISNetworkACL()
+	resource.Create = resourceIBMISVPCDefaultNetworkACLCreate
+	resource.Delete = resourceIBMISVPCDefaultNetworkACLDelete
+
+	// The default network ACL is created with the VPC, so it is looked up
+	// from the VPC instead of being created in a resource group.
+	resource.Schema[isNetworkACLVPC] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The VPC whose default network ACL is managed",
+	}
+	resource.Schema[isNetworkACLResourceGroup] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Resource group ID for the network ACL",
+	}
+	return resource
+}
+
+func resourceIBMISVPCDefaultNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID := d.Get(isNetworkACLVPC).(string)
+	getVPCDefaultNetworkACLOptions := &vpcv1.GetVPCDefaultNetworkACLOptions{
+		ID: &vpcID,
+	}
+	nwacl, response, err := sess.GetVPCDefaultNetworkACL(getVPCDefaultNetworkACLOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting default network ACL of VPC (%s): %s\n%s", vpcID, err, response)
+	}
+	d.SetId(*nwacl.ID)
+	d.Set(isNetworkACLCRN, *nwacl.CRN)
+	log.Printf("[INFO] Default network ACL of VPC (%s): %s", vpcID, *nwacl.ID)
+
+	name := ""
+	hasChanged := false
+	if nm, ok := d.GetOk(isNetworkACLName); ok && nm.(string) != *nwacl.Name {
+		name = nm.(string)
+		hasChanged = true
+	}
+	err = nwaclUpdate(d, meta, d.Id(), name, hasChanged)
+	if err != nil {
+		return err
+	}
+	return resourceIBMISNetworkACLRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+	// The default network ACL is deleted with its VPC, so it is only removed
+	// from the state and its rules are left as they are.
+	log.Printf("[DEBUG] Removing default network ACL (%s) from the state", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCDefaultNetworkACL_basic(t *testing.T) {
+	var nwACL string
+	vpcname := fmt.Sprintf("tfdacl-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfdacl-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultNetworkACLConfig(vpcname, name, "allow"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISNetworkACLExists("ibm_is_vpc_default_network_acl.testacc_default_acl", nwACL),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_vpc_default_network_acl.testacc_default_acl", "id", "ibm_is_vpc.testacc_vpc", "default_network_acl"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_network_acl.testacc_default_acl", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_network_acl.testacc_default_acl", "rules.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_network_acl.testacc_default_acl", "rules.0.action", "allow"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCDefaultNetworkACLConfig(vpcname, name, "deny"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISNetworkACLExists("ibm_is_vpc_default_network_acl.testacc_default_acl", nwACL),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_network_acl.testacc_default_acl", "rules.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_network_acl.testacc_default_acl", "rules.0.action", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCDefaultNetworkACLConfig(vpcname, name, action string) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_vpc_default_network_acl" "testacc_default_acl" {
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	name = "%s"
+
+	rules {
+		name        = "inbound"
+		action      = "%s"
+		source      = "0.0.0.0/0"
+		destination = "0.0.0.0/0"
+		direction   = "inbound"
+		icmp {
+			type = 8
+		}
+	}
+	rules {
+		name        = "outbound"
+		action      = "allow"
+		source      = "0.0.0.0/0"
+		destination = "0.0.0.0/0"
+		direction   = "outbound"
+	}
+}`, vpcname, name, action)
+}
@@ -0,0 +1,394 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isDefaultSecurityGroupRuleID = "rule_id"
+)
+
+func ResourceIBMISVPCDefaultSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISVPCDefaultSecurityGroupCreate,
+		Read:     resourceIBMISVPCDefaultSecurityGroupRead,
+		Update:   resourceIBMISVPCDefaultSecurityGroupUpdate,
+		Delete:   resourceIBMISVPCDefaultSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupVPC: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC whose default security group is managed",
+			},
+
+			isSecurityGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Security group name",
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group", isSecurityGroupName),
+			},
+
+			isSecurityGroupRules: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "The rules of the default security group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isDefaultSecurityGroupRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Rule id",
+						},
+						isSecurityGroupRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Direction of traffic to enforce, either inbound or outbound",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+						},
+						isSecurityGroupRuleIPVersion: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isSecurityGroupRuleIPVersionDefault,
+							Description:  "IP version: ipv4",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+						},
+						isSecurityGroupRuleRemote: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
+						},
+						isSecurityGroupRuleProtocolICMP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=icmp",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isSecurityGroupRuleType: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+									},
+									isSecurityGroupRuleCode: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+									},
+								},
+							},
+						},
+						isSecurityGroupRuleProtocolTCP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=tcp",
+							Elem: &schema.Resource{
+								Schema: makeIBMISVPCDefaultSecurityGroupPortSchema(),
+							},
+						},
+						isSecurityGroupRuleProtocolUDP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=udp",
+							Elem: &schema.Resource{
+								Schema: makeIBMISVPCDefaultSecurityGroupPortSchema(),
+							},
+						},
+					},
+				},
+			},
+
+			isSecurityGroupCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the resource",
+			},
+
+			isSecurityGroupResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource Group ID",
+			},
+		},
+	}
+}
+
+func makeIBMISVPCDefaultSecurityGroupPortSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		isSecurityGroupRulePortMin: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+		},
+		isSecurityGroupRulePortMax: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      65535,
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+		},
+	}
+}
+
+func resourceIBMISVPCDefaultSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID := d.Get(isSecurityGroupVPC).(string)
+	getVPCDefaultSecurityGroupOptions := &vpcv1.GetVPCDefaultSecurityGroupOptions{
+		ID: &vpcID,
+	}
+	group, response, err := sess.GetVPCDefaultSecurityGroup(getVPCDefaultSecurityGroupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting default security group of VPC (%s): %s\n%s", vpcID, err, response)
+	}
+	d.SetId(*group.ID)
+	log.Printf("[INFO] Default security group of VPC (%s): %s", vpcID, *group.ID)
+
+	if nm, ok := d.GetOk(isSecurityGroupName); ok && nm.(string) != *group.Name {
+		err = sgNameUpdate(sess, d.Id(), nm.(string))
+		if err != nil {
+			return err
+		}
+	}
+	if rules, ok := d.GetOk(isSecurityGroupRules); ok {
+		err = defaultSecurityGroupRulesUpdate(sess, d.Id(), flattenIBMISVPCDefaultSecurityGroupRules(group.Rules), rules.(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMISVPCDefaultSecurityGroupRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &id,
+	}
+	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response)
+	}
+	d.Set(isSecurityGroupVPC, *group.VPC.ID)
+	d.Set(isSecurityGroupName, *group.Name)
+	d.Set(isSecurityGroupCRN, *group.CRN)
+	if group.ResourceGroup != nil {
+		d.Set(isSecurityGroupResourceGroup, *group.ResourceGroup.ID)
+	}
+	if err = d.Set(isSecurityGroupRules, flattenIBMISVPCDefaultSecurityGroupRules(group.Rules)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting rules of Security Group (%s): %s", id, err)
+	}
+	return nil
+}
+
+func resourceIBMISVPCDefaultSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+
+	if d.HasChange(isSecurityGroupName) {
+		err = sgNameUpdate(sess, id, d.Get(isSecurityGroupName).(string))
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange(isSecurityGroupRules) {
+		getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+			ID: &id,
+		}
+		group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response)
+		}
+		err = defaultSecurityGroupRulesUpdate(sess, id, flattenIBMISVPCDefaultSecurityGroupRules(group.Rules), d.Get(isSecurityGroupRules).(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMISVPCDefaultSecurityGroupRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	// The default security group is deleted with its VPC, so it is only
+	// removed from the state and its rules are left as they are.
+	log.Printf("[DEBUG] Removing default security group (%s) from the state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// defaultSecurityGroupRulesUpdate deletes the current rules that are not
+// configured and creates the configured rules that do not exist yet.
+func defaultSecurityGroupRulesUpdate(sess *vpcv1.VpcV1, sgID string, current []interface{}, configured *schema.Set) error {
+	currentSet := schema.NewSet(configured.F, current)
+
+	for _, r := range currentSet.Difference(configured).List() {
+		ruleID := r.(map[string]interface{})[isDefaultSecurityGroupRuleID].(string)
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &sgID,
+			ID:              &ruleID,
+		}
+		response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting rule (%s) of Security Group (%s): %s\n%s", ruleID, sgID, err, response)
+		}
+	}
+	for _, r := range configured.Difference(currentSet).List() {
+		prototype, err := expandIBMISVPCDefaultSecurityGroupRule(r.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		createSecurityGroupRuleOptions := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &sgID,
+			SecurityGroupRulePrototype: prototype,
+		}
+		_, response, err := sess.CreateSecurityGroupRule(createSecurityGroupRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating rule of Security Group (%s): %s\n%s", sgID, err, response)
+		}
+	}
+	return nil
+}
+
+func expandIBMISVPCDefaultSecurityGroupRule(rule map[string]interface{}) (*vpcv1.SecurityGroupRulePrototype, error) {
+	direction := rule[isSecurityGroupRuleDirection].(string)
+	ipVersion := rule[isSecurityGroupRuleIPVersion].(string)
+	protocol := "all"
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &direction,
+		IPVersion: &ipVersion,
+	}
+
+	address, cidr, id, err := inferRemoteSecurityGroup(rule[isSecurityGroupRuleRemote].(string))
+	if err != nil {
+		return nil, err
+	}
+	remote := &vpcv1.SecurityGroupRuleRemotePrototype{}
+	if address != "" {
+		remote.Address = &address
+	} else if cidr != "" {
+		remote.CIDRBlock = &cidr
+	} else if id != "" {
+		remote.ID = &id
+	}
+	prototype.Remote = remote
+
+	if icmp := rule[isSecurityGroupRuleProtocolICMP].([]interface{}); len(icmp) > 0 {
+		protocol = "icmp"
+		if icmp[0] != nil {
+			icmpMap := icmp[0].(map[string]interface{})
+			// A type of 0 is treated as unset, so that all ICMP types are allowed.
+			if icmpType := int64(icmpMap[isSecurityGroupRuleType].(int)); icmpType != 0 {
+				prototype.Type = &icmpType
+				if icmpCode := int64(icmpMap[isSecurityGroupRuleCode].(int)); icmpCode != 0 {
+					prototype.Code = &icmpCode
+				}
+			}
+		}
+	}
+	for _, prot := range []string{isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+		if ports := rule[prot].([]interface{}); len(ports) > 0 {
+			if protocol != "all" {
+				return nil, fmt.Errorf("[ERROR] Only one of icmp, tcp, or udp can be defined per rule")
+			}
+			protocol = prot
+			portMin, portMax := int64(1), int64(65535)
+			if ports[0] != nil {
+				portsMap := ports[0].(map[string]interface{})
+				portMin = int64(portsMap[isSecurityGroupRulePortMin].(int))
+				portMax = int64(portsMap[isSecurityGroupRulePortMax].(int))
+			}
+			prototype.PortMin = &portMin
+			prototype.PortMax = &portMax
+		}
+	}
+	prototype.Protocol = &protocol
+	return prototype, nil
+}
+
+func flattenIBMISVPCDefaultSecurityGroupRules(rules []vpcv1.SecurityGroupRuleIntf) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		r := map[string]interface{}{
+			isSecurityGroupRuleProtocolICMP: []interface{}{},
+			isSecurityGroupRuleProtocolTCP:  []interface{}{},
+			isSecurityGroupRuleProtocolUDP:  []interface{}{},
+		}
+		var remoteIntf vpcv1.SecurityGroupRuleRemoteIntf
+		switch reflect.TypeOf(rule).String() {
+		case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
+			rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp)
+			r[isDefaultSecurityGroupRuleID] = *rule.ID
+			r[isSecurityGroupRuleDirection] = *rule.Direction
+			r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+			icmp := map[string]interface{}{
+				isSecurityGroupRuleType: 0,
+				isSecurityGroupRuleCode: 0,
+			}
+			if rule.Type != nil {
+				icmp[isSecurityGroupRuleType] = int(*rule.Type)
+			}
+			if rule.Code != nil {
+				icmp[isSecurityGroupRuleCode] = int(*rule.Code)
+			}
+			r[isSecurityGroupRuleProtocolICMP] = []interface{}{icmp}
+			remoteIntf = rule.Remote
+		case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp":
+			rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp)
+			r[isDefaultSecurityGroupRuleID] = *rule.ID
+			r[isSecurityGroupRuleDirection] = *rule.Direction
+			r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+			r[*rule.Protocol] = []interface{}{
+				map[string]interface{}{
+					isSecurityGroupRulePortMin: checkNetworkACLNil(rule.PortMin),
+					isSecurityGroupRulePortMax: checkNetworkACLNil(rule.PortMax),
+				},
+			}
+			remoteIntf = rule.Remote
+		case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll":
+			rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
+			r[isDefaultSecurityGroupRuleID] = *rule.ID
+			r[isSecurityGroupRuleDirection] = *rule.Direction
+			r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+			remoteIntf = rule.Remote
+		default:
+			continue
+		}
+		if remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+			if remote.ID != nil {
+				r[isSecurityGroupRuleRemote] = *remote.ID
+			} else if remote.Address != nil {
+				r[isSecurityGroupRuleRemote] = *remote.Address
+			} else if remote.CIDRBlock != nil {
+				r[isSecurityGroupRuleRemote] = *remote.CIDRBlock
+			}
+		}
+		result = append(result, r)
+	}
+	return result
+}
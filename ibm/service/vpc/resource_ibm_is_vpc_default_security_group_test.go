@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPCDefaultSecurityGroup_basic(t *testing.T) {
+	var securityGroup string
+	vpcname := fmt.Sprintf("tfdsg-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfdsg-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultSecurityGroupConfig(vpcname, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupExists("ibm_is_vpc_default_security_group.testacc_default_sg", securityGroup),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_vpc_default_security_group.testacc_default_sg", "id", "ibm_is_vpc.testacc_vpc", "default_security_group"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_security_group.testacc_default_sg", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_security_group.testacc_default_sg", "rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCDefaultSecurityGroupConfig(vpcname, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupExists("ibm_is_vpc_default_security_group.testacc_default_sg", securityGroup),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_security_group.testacc_default_sg", "rules.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc_default_security_group.testacc_default_sg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCDefaultSecurityGroupConfig(vpcname, name string, allowSSH bool) string {
+	sshRule := ""
+	if allowSSH {
+		sshRule = `
+	rules {
+		direction = "inbound"
+		remote    = "0.0.0.0/0"
+		tcp {
+			port_min = 22
+			port_max = 22
+		}
+	}`
+	}
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_vpc_default_security_group" "testacc_default_sg" {
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	name = "%s"
+
+	rules {
+		direction = "outbound"
+		remote    = "0.0.0.0/0"
+	}%s
+}`, vpcname, name, sshRule)
+}
@@ -0,0 +1,431 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resource that keeps the static routes of a route-based VPN connection in a set of routing
+// tables. The routes use the connection as next hop, so the VPC keeps sending the traffic to
+// the active member of the VPN gateway when the members fail over.
+func ResourceIBMISVPNGatewayConnectionRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISVPNGatewayConnectionRoutesCreate,
+		ReadContext:   resourceIBMISVPNGatewayConnectionRoutesRead,
+		UpdateContext: resourceIBMISVPNGatewayConnectionRoutesUpdate,
+		DeleteContext: resourceIBMISVPNGatewayConnectionRoutesDelete,
+		CustomizeDiff: resourceIBMISVPNGatewayConnectionRoutesCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the route-based VPN gateway",
+			},
+			"vpn_gateway_connection": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the VPN gateway connection used as next hop",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone the routes apply to",
+			},
+			"routing_tables": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The routing tables of the VPN gateway VPC to create the routes in",
+			},
+			"destinations": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The destination CIDR blocks routed through the connection",
+			},
+			"replace_member_routes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Replace the routes of the destinations whose next hop is the private IP of a VPN gateway member",
+			},
+			"vpc": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VPC of the VPN gateway",
+			},
+			"routes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes maintained for the connection",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"routing_table": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The routing table of the route",
+						},
+						"destination": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The destination of the route",
+						},
+						"route_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the route",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// vpnConnectionRoutesTarget holds what the routes of a connection are reconciled against.
+type vpnConnectionRoutesTarget struct {
+	vpc        string
+	connection string
+	zone       string
+	memberIPs  map[string]bool
+}
+
+func resourceIBMISVPNGatewayConnectionRoutesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayID := d.Get("vpn_gateway").(string)
+	connectionID := d.Get("vpn_gateway_connection").(string)
+	target, _, err := isVPNConnectionRoutesTarget(context, sess, gatewayID, connectionID, d.Get("zone").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", gatewayID, connectionID, target.zone))
+	tables := flex.ExpandStringList(d.Get("routing_tables").(*schema.Set).List())
+	err = isVPNConnectionRoutesApply(context, sess, target, tables, tables, flex.ExpandStringList(d.Get("destinations").(*schema.Set).List()), nil, d.Get("replace_member_routes").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMISVPNGatewayConnectionRoutesRead(context, d, meta)
+}
+
+func resourceIBMISVPNGatewayConnectionRoutesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	target, found, err := isVPNConnectionRoutesTarget(context, sess, d.Get("vpn_gateway").(string), d.Get("vpn_gateway_connection").(string), d.Get("zone").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	destinations := map[string]bool{}
+	for _, destination := range flex.ExpandStringList(d.Get("destinations").(*schema.Set).List()) {
+		destinations[destination] = true
+	}
+	tables := flex.ExpandStringList(d.Get("routing_tables").(*schema.Set).List())
+	sort.Strings(tables)
+
+	routes := []map[string]interface{}{}
+	for _, table := range tables {
+		tableRoutes, err := isVPNConnectionRoutesList(context, sess, target, table)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, route := range tableRoutes {
+			if isVPNConnectionRouteMatches(route, target) && destinations[*route.Destination] {
+				routes = append(routes, map[string]interface{}{
+					"routing_table": table,
+					"destination":   *route.Destination,
+					"route_id":      *route.ID,
+				})
+			}
+		}
+	}
+
+	if err = d.Set("vpc", target.vpc); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpc: %s", err))
+	}
+	if err = d.Set("routes", routes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting routes: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMISVPNGatewayConnectionRoutesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	target, _, err := isVPNConnectionRoutesTarget(context, sess, d.Get("vpn_gateway").(string), d.Get("vpn_gateway_connection").(string), d.Get("zone").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Tables removed from the configuration are reconciled too, so that their routes are deleted.
+	// Only the destinations of the previous apply are deleted, the other routes of the connection
+	// may be maintained by other configurations.
+	oldTables, newTables := d.GetChange("routing_tables")
+	oldDestinations, newDestinations := d.GetChange("destinations")
+	tables := flex.ExpandStringList(oldTables.(*schema.Set).Union(newTables.(*schema.Set)).List())
+	err = isVPNConnectionRoutesApply(context, sess, target, tables, flex.ExpandStringList(newTables.(*schema.Set).List()), flex.ExpandStringList(newDestinations.(*schema.Set).List()), flex.ExpandStringList(oldDestinations.(*schema.Set).List()), d.Get("replace_member_routes").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMISVPNGatewayConnectionRoutesRead(context, d, meta)
+}
+
+func resourceIBMISVPNGatewayConnectionRoutesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	target, found, err := isVPNConnectionRoutesTarget(context, sess, d.Get("vpn_gateway").(string), d.Get("vpn_gateway_connection").(string), d.Get("zone").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if found {
+		// Only the routes known to the resource are deleted, routes of other destinations are kept.
+		tables := flex.ExpandStringList(d.Get("routing_tables").(*schema.Set).List())
+		destinations := map[string]bool{}
+		for _, destination := range flex.ExpandStringList(d.Get("destinations").(*schema.Set).List()) {
+			destinations[destination] = true
+		}
+		for _, table := range tables {
+			tableRoutes, err := isVPNConnectionRoutesList(context, sess, target, table)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for _, route := range tableRoutes {
+				if isVPNConnectionRouteMatches(route, target) && destinations[*route.Destination] {
+					if err = isVPNConnectionRouteDelete(context, sess, target, table, *route.ID); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMISVPNGatewayConnectionRoutesCustomizeDiff plans an update when a route was deleted
+// outside of Terraform, or when the routing tables or destinations change.
+func resourceIBMISVPNGatewayConnectionRoutesCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if !diff.NewValueKnown("routing_tables") || !diff.NewValueKnown("destinations") {
+		return diff.SetNewComputed("routes")
+	}
+
+	existing := map[string]bool{}
+	for _, r := range diff.Get("routes").([]interface{}) {
+		route := r.(map[string]interface{})
+		existing[route["routing_table"].(string)+"/"+route["destination"].(string)] = true
+	}
+	wanted := 0
+	for _, table := range diff.Get("routing_tables").(*schema.Set).List() {
+		for _, destination := range diff.Get("destinations").(*schema.Set).List() {
+			if !existing[table.(string)+"/"+destination.(string)] {
+				return diff.SetNewComputed("routes")
+			}
+			wanted++
+		}
+	}
+	if wanted != len(existing) {
+		return diff.SetNewComputed("routes")
+	}
+	return nil
+}
+
+// isVPNConnectionRoutesTarget checks that the connection belongs to the route-based gateway, and
+// returns the VPC and member private IPs of the gateway. found is false when either is deleted.
+func isVPNConnectionRoutesTarget(context context.Context, sess *vpcv1.VpcV1, gatewayID, connectionID, zone string) (target vpnConnectionRoutesTarget, found bool, err error) {
+	getVPNGatewayOptions := sess.NewGetVPNGatewayOptions(gatewayID)
+	vpnGatewayIntf, response, err := sess.GetVPNGatewayWithContext(context, getVPNGatewayOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return target, false, nil
+		}
+		return target, false, fmt.Errorf("[ERROR] Error getting VPN gateway %s: %s\n%s", gatewayID, err, response)
+	}
+	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
+	if vpnGateway.Mode == nil || *vpnGateway.Mode != vpcv1.VPNGatewayModeRouteConst {
+		return target, false, fmt.Errorf("[ERROR] VPN gateway %s is not a route-based gateway, the routes of policy-based connections are managed by the VPN gateway", gatewayID)
+	}
+
+	found = false
+	for _, connection := range vpnGateway.Connections {
+		if *connection.ID == connectionID {
+			found = true
+		}
+	}
+	if !found {
+		return target, false, nil
+	}
+
+	target = vpnConnectionRoutesTarget{
+		vpc:        *vpnGateway.VPC.ID,
+		connection: connectionID,
+		zone:       zone,
+		memberIPs:  map[string]bool{},
+	}
+	for _, member := range vpnGateway.Members {
+		if member.PrivateIP != nil && member.PrivateIP.Address != nil {
+			target.memberIPs[*member.PrivateIP.Address] = true
+		}
+	}
+	return target, true, nil
+}
+
+// isVPNConnectionRoutesApply creates the routes of the destinations in the wanted tables, and
+// deletes the routes of the connection to the old destinations that are not wanted anymore from
+// all the given tables.
+func isVPNConnectionRoutesApply(context context.Context, sess *vpcv1.VpcV1, target vpnConnectionRoutesTarget, tables, wantedTables, destinations, oldDestinations []string, replaceMemberRoutes bool) error {
+	wanted := map[string]bool{}
+	for _, table := range wantedTables {
+		wanted[table] = true
+	}
+	managed := map[string]bool{}
+	for _, destination := range oldDestinations {
+		managed[destination] = true
+	}
+
+	for _, table := range tables {
+		tableRoutes, err := isVPNConnectionRoutesList(context, sess, target, table)
+		if err != nil {
+			return err
+		}
+
+		desired := map[string]bool{}
+		if wanted[table] {
+			for _, destination := range destinations {
+				desired[destination] = true
+			}
+		}
+
+		existing := map[string]bool{}
+		for _, route := range tableRoutes {
+			if *route.Zone.Name != target.zone {
+				continue
+			}
+			nextHop, ok := route.NextHop.(*vpcv1.RouteNextHop)
+			if !ok {
+				continue
+			}
+			switch {
+			case nextHop.ID != nil && *nextHop.ID == target.connection:
+				if desired[*route.Destination] {
+					existing[*route.Destination] = true
+					continue
+				}
+				if !managed[*route.Destination] {
+					continue
+				}
+				if err = isVPNConnectionRouteDelete(context, sess, target, table, *route.ID); err != nil {
+					return err
+				}
+			case replaceMemberRoutes && nextHop.Address != nil && target.memberIPs[*nextHop.Address] && desired[*route.Destination]:
+				// A route to a member stops working when the members fail over, it is replaced
+				// by a route to the connection.
+				log.Printf("[INFO] Replacing route %s to VPN gateway member %s in routing table %s", *route.ID, *nextHop.Address, table)
+				if err = isVPNConnectionRouteDelete(context, sess, target, table, *route.ID); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, destination := range destinations {
+			if !desired[destination] || existing[destination] {
+				continue
+			}
+			createVPCRoutingTableRouteOptions := sess.NewCreateVPCRoutingTableRouteOptions(target.vpc, table, destination, &vpcv1.ZoneIdentityByName{
+				Name: core.StringPtr(target.zone),
+			})
+			createVPCRoutingTableRouteOptions.SetNextHop(&vpcv1.RoutePrototypeNextHopRouteNextHopPrototypeVPNGatewayConnectionIdentity{
+				ID: core.StringPtr(target.connection),
+			})
+			createVPCRoutingTableRouteOptions.SetAction(vpcv1.RouteActionDeliverConst)
+			_, response, err := sess.CreateVPCRoutingTableRouteWithContext(context, createVPCRoutingTableRouteOptions)
+			if err != nil {
+				log.Printf("[DEBUG] CreateVPCRoutingTableRouteWithContext failed %s\n%s", err, response)
+				return fmt.Errorf("[ERROR] Error creating route to %s in routing table %s: %s\n%s", destination, table, err, response)
+			}
+		}
+	}
+	return nil
+}
+
+func isVPNConnectionRoutesList(context context.Context, sess *vpcv1.VpcV1, target vpnConnectionRoutesTarget, table string) ([]vpcv1.Route, error) {
+	routes := []vpcv1.Route{}
+	start := ""
+	for {
+		listVPCRoutingTableRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(target.vpc, table)
+		if start != "" {
+			listVPCRoutingTableRoutesOptions.Start = &start
+		}
+		routeCollection, response, err := sess.ListVPCRoutingTableRoutesWithContext(context, listVPCRoutingTableRoutesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListVPCRoutingTableRoutesWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("[ERROR] Error listing routes of routing table %s: %s\n%s", table, err, response)
+		}
+		routes = append(routes, routeCollection.Routes...)
+		start = flex.GetNext(routeCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return routes, nil
+}
+
+func isVPNConnectionRouteMatches(route vpcv1.Route, target vpnConnectionRoutesTarget) bool {
+	nextHop, ok := route.NextHop.(*vpcv1.RouteNextHop)
+	return ok && nextHop.ID != nil && *nextHop.ID == target.connection && *route.Zone.Name == target.zone
+}
+
+func isVPNConnectionRouteDelete(context context.Context, sess *vpcv1.VpcV1, target vpnConnectionRoutesTarget, table, routeID string) error {
+	deleteVPCRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(target.vpc, table, routeID)
+	response, err := sess.DeleteVPCRoutingTableRouteWithContext(context, deleteVPCRoutingTableRouteOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteVPCRoutingTableRouteWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error deleting route %s of routing table %s: %s\n%s", routeID, table, err, response)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPNGatewayConnectionRoutes_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfvpngcr-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpngcr-subnet-%d", acctest.RandIntRange(10, 100))
+	vpnname := fmt.Sprintf("tfvpngcr-vpn-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfvpngcr-conn-%d", acctest.RandIntRange(10, 100))
+	rtname := fmt.Sprintf("tfvpngcr-rt-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNGatewayConnectionRoutesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionRoutesConfig(vpcname, subnetname, vpnname, name, rtname, "[ibm_is_vpc_routing_table.testacc_rt1.routing_table]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection_routes.testacc_routes", "routes.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_vpn_gateway_connection_routes.testacc_routes", "vpc"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionRoutesConfig(vpcname, subnetname, vpnname, name, rtname, "[ibm_is_vpc_routing_table.testacc_rt1.routing_table, ibm_is_vpc_routing_table.testacc_rt2.routing_table]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection_routes.testacc_routes", "routes.#", "4"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection_routes.testacc_routes", "routing_tables.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNGatewayConnectionRoutesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpn_gateway_connection_routes" {
+			continue
+		}
+		for key, table := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "routing_tables.") || key == "routing_tables.#" {
+				continue
+			}
+			listVPCRoutingTableRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(rs.Primary.Attributes["vpc"], table)
+			routes, _, err := sess.ListVPCRoutingTableRoutes(listVPCRoutingTableRoutesOptions)
+			if err != nil {
+				// The routing table is deleted with the rest of the configuration.
+				continue
+			}
+			for _, route := range routes.Routes {
+				for key, id := range rs.Primary.Attributes {
+					if strings.HasSuffix(key, ".route_id") && id == *route.ID {
+						return fmt.Errorf("Route %s of the VPN gateway connection still exists", id)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISVPNGatewayConnectionRoutesConfig(vpc, subnet, vpnname, name, rtname, tables string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "testacc_VPNGateway" {
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet.id
+		mode = "route"
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection" {
+		name = "%s"
+		vpn_gateway = ibm_is_vpn_gateway.testacc_VPNGateway.id
+		peer_address = "1.2.3.4"
+		preshared_key = "VPNDemoPassword"
+	}
+	resource "ibm_is_vpc_routing_table" "testacc_rt1" {
+		vpc = ibm_is_vpc.testacc_vpc.id
+		name = "%s-1"
+	}
+	resource "ibm_is_vpc_routing_table" "testacc_rt2" {
+		vpc = ibm_is_vpc.testacc_vpc.id
+		name = "%s-2"
+	}
+	resource "ibm_is_vpn_gateway_connection_routes" "testacc_routes" {
+		vpn_gateway = ibm_is_vpn_gateway.testacc_VPNGateway.id
+		vpn_gateway_connection = ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection.gateway_connection
+		zone = "%s"
+		routing_tables = %s
+		destinations = ["192.168.10.0/24", "192.168.20.0/24"]
+	}
+	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, name, rtname, rtname, acc.ISZoneName, tables)
+}
@@ -484,3 +484,61 @@ func testAccCheckIBMISVPNGatewayConnectionNullPatchConfig(vpc, subnet, vpnname,
 	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, ikepolicyname, ipsecpolicyname, name, noNullPass, noNullPass)
 
 }
+
+func TestAccIBMISVPNGatewayConnection_pskSecret(t *testing.T) {
+	var VPNGatewayConnection string
+	vpcname := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(10, 100))
+	vpnname := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(10, 100))
+	secretname := fmt.Sprintf("tfvpngc-psk-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionPSKSecretConfig(vpcname, subnetname, vpnname, secretname, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "preshared_key", ""),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "preshared_key_secret_version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNGatewayConnectionPSKSecretConfig(vpc, subnet, vpnname, secretname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = "${ibm_is_vpc.testacc_vpc.id}"
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "testacc_VPNGateway" {
+		name = "%s"
+		subnet = "${ibm_is_subnet.testacc_subnet.id}"
+	}
+	resource "ibm_sm_arbitrary_secret" "testacc_psk" {
+		instance_id = "%s"
+		region = "%s"
+		name = "%s"
+		payload = "VPNDemoPassword"
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection" {
+		name = "%s"
+		vpn_gateway = "${ibm_is_vpn_gateway.testacc_VPNGateway.id}"
+		peer_address = "${ibm_is_vpn_gateway.testacc_VPNGateway.public_ip_address}"
+		preshared_key_secret_crn = "${ibm_sm_arbitrary_secret.testacc_psk.crn}"
+	}
+	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, secretname, name)
+
+}
@@ -4,12 +4,16 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,6 +27,9 @@ const (
 	isVPNGatewayConnection                          = "gateway_connection"
 	isVPNGatewayConnectionPeerAddress               = "peer_address"
 	isVPNGatewayConnectionPreSharedKey              = "preshared_key"
+	isVPNGatewayConnectionPreSharedKeySecretCRN     = "preshared_key_secret_crn"
+	isVPNGatewayConnectionPreSharedKeySecretVersion = "preshared_key_secret_version"
+	isVPNGatewayConnectionPreSharedKeyVersionID     = "preshared_key_secret_version_id"
 	isVPNGatewayConnectionLocalCIDRS                = "local_cidrs"
 	isVPNGatewayConnectionPeerCIDRS                 = "peer_cidrs"
 	isVPNGatewayConnectionIKEPolicy                 = "ike_policy"
@@ -54,6 +61,8 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceIBMISVPNGatewayConnectionPSKSecretDiff,
+
 		Schema: map[string]*schema.Schema{
 
 			isVPNGatewayConnectionName: {
@@ -77,9 +86,31 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 			},
 
 			isVPNGatewayConnectionPreSharedKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{isVPNGatewayConnectionPreSharedKey, isVPNGatewayConnectionPreSharedKeySecretCRN},
+				Description:  "vpn gateway",
+			},
+
+			isVPNGatewayConnectionPreSharedKeySecretCRN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{isVPNGatewayConnectionPreSharedKey, isVPNGatewayConnectionPreSharedKeySecretCRN},
+				Description:  "CRN of the Secrets Manager arbitrary secret holding the preshared key",
+			},
+
+			isVPNGatewayConnectionPreSharedKeySecretVersion: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{isVPNGatewayConnectionPreSharedKeySecretCRN},
+				Description:  "Version of the preshared key secret to use, defaults to the current version",
+			},
+
+			isVPNGatewayConnectionPreSharedKeyVersionID: {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "vpn gateway",
+				Computed:    true,
+				Description: "ID of the preshared key secret version applied to the connection",
 			},
 
 			isVPNGatewayConnectionAdminStateup: {
@@ -254,6 +285,14 @@ func resourceIBMISVPNGatewayConnectionCreate(d *schema.ResourceData, meta interf
 	gatewayID := d.Get(isVPNGatewayConnectionVPNGateway).(string)
 	peerAddress := d.Get(isVPNGatewayConnectionPeerAddress).(string)
 	prephasedKey := d.Get(isVPNGatewayConnectionPreSharedKey).(string)
+	if secretCRN, ok := d.GetOk(isVPNGatewayConnectionPreSharedKeySecretCRN); ok {
+		psk, versionID, err := vpngwconGetPSKSecret(meta, secretCRN.(string), d.Get(isVPNGatewayConnectionPreSharedKeySecretVersion).(string))
+		if err != nil {
+			return err
+		}
+		prephasedKey = psk
+		d.Set(isVPNGatewayConnectionPreSharedKeyVersionID, versionID)
+	}
 
 	stateUp := false
 	if _, ok := d.GetOk(isVPNGatewayConnectionAdminStateup); ok {
@@ -385,7 +424,12 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	d.Set(isVPNGatewayConnectionVPNGateway, gID)
 	d.Set(isVPNGatewayConnectionAdminStateup, *vpnGatewayConnection.AdminStateUp)
 	d.Set(isVPNGatewayConnectionPeerAddress, *vpnGatewayConnection.PeerAddress)
-	d.Set(isVPNGatewayConnectionPreSharedKey, *vpnGatewayConnection.Psk)
+	// the preshared key is kept out of the state when it comes from Secrets Manager
+	if _, ok := d.GetOk(isVPNGatewayConnectionPreSharedKeySecretCRN); ok {
+		d.Set(isVPNGatewayConnectionPreSharedKey, "")
+	} else {
+		d.Set(isVPNGatewayConnectionPreSharedKey, *vpnGatewayConnection.Psk)
+	}
 
 	if vpnGatewayConnection.LocalCIDRs != nil {
 		d.Set(isVPNGatewayConnectionLocalCIDRS, flex.FlattenStringList(vpnGatewayConnection.LocalCIDRs))
@@ -486,9 +530,20 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		hasChanged = true
 	}
 
-	if d.HasChange(isVPNGatewayConnectionPreSharedKey) {
+	if secretCRN, ok := d.GetOk(isVPNGatewayConnectionPreSharedKeySecretCRN); ok {
+		if d.HasChange(isVPNGatewayConnectionPreSharedKeySecretCRN) || d.HasChange(isVPNGatewayConnectionPreSharedKeySecretVersion) || d.HasChange(isVPNGatewayConnectionPreSharedKeyVersionID) {
+			psk, versionID, err := vpngwconGetPSKSecret(meta, secretCRN.(string), d.Get(isVPNGatewayConnectionPreSharedKeySecretVersion).(string))
+			if err != nil {
+				return err
+			}
+			vpnGatewayConnectionPatchModel.Psk = &psk
+			d.Set(isVPNGatewayConnectionPreSharedKeyVersionID, versionID)
+			hasChanged = true
+		}
+	} else if d.HasChange(isVPNGatewayConnectionPreSharedKey) {
 		psk := d.Get(isVPNGatewayConnectionPreSharedKey).(string)
 		vpnGatewayConnectionPatchModel.Psk = &psk
+		d.Set(isVPNGatewayConnectionPreSharedKeyVersionID, "")
 		hasChanged = true
 	}
 
@@ -677,3 +732,52 @@ func vpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	}
 	return true, nil
 }
+
+// resourceIBMISVPNGatewayConnectionPSKSecretDiff plans a preshared key update when the secret it is read from
+// has been rotated in Secrets Manager since it was last applied to the connection
+func resourceIBMISVPNGatewayConnectionPSKSecretDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	secretCRN, ok := diff.GetOk(isVPNGatewayConnectionPreSharedKeySecretCRN)
+	if !ok || diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChange(isVPNGatewayConnectionPreSharedKeySecretCRN) || diff.HasChange(isVPNGatewayConnectionPreSharedKeySecretVersion) {
+		return diff.SetNewComputed(isVPNGatewayConnectionPreSharedKeyVersionID)
+	}
+	if !diff.NewValueKnown(isVPNGatewayConnectionPreSharedKeySecretCRN) {
+		return nil
+	}
+	_, versionID, err := vpngwconGetPSKSecret(meta, secretCRN.(string), diff.Get(isVPNGatewayConnectionPreSharedKeySecretVersion).(string))
+	if err != nil {
+		return err
+	}
+	if versionID != diff.Get(isVPNGatewayConnectionPreSharedKeyVersionID).(string) {
+		log.Printf("[INFO] Preshared key secret %s has a new version %s", secretCRN.(string), versionID)
+		return diff.SetNewComputed(isVPNGatewayConnectionPreSharedKeyVersionID)
+	}
+	return nil
+}
+
+// vpngwconGetPSKSecret returns the payload and version ID of a version of the arbitrary secret holding the preshared key
+func vpngwconGetPSKSecret(meta interface{}, secretCRN, version string) (string, string, error) {
+	smClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return "", "", err
+	}
+	smClient, secretID, err := secretsmanager.GetClientForSecretCRN(smClient, secretCRN)
+	if err != nil {
+		return "", "", err
+	}
+	if version == "" {
+		version = "current"
+	}
+	getSecretVersionOptions := smClient.NewGetSecretVersionOptions(secretID, version)
+	secretVersionIntf, response, err := smClient.GetSecretVersion(getSecretVersionOptions)
+	if err != nil {
+		return "", "", fmt.Errorf("[ERROR] Error getting version %s of preshared key secret %s: %s\n%s", version, secretCRN, err, response)
+	}
+	secretVersion, ok := secretVersionIntf.(*secretsmanagerv2.ArbitrarySecretVersion)
+	if !ok || secretVersion.Payload == nil {
+		return "", "", fmt.Errorf("[ERROR] Preshared key secret %s is not an arbitrary secret with a payload", secretCRN)
+	}
+	return *secretVersion.Payload, *secretVersion.ID, nil
+}
@@ -403,6 +403,25 @@ func ValidateIP(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidateSecretReference validates the format of values that look like a secret reference, either
+// {vault::integration_name.secret_name} or the CRN of a Secrets Manager secret. Any other value is a raw secret.
+func ValidateSecretReference(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch {
+	case strings.HasPrefix(value, "{vault::"):
+		if !flex.SecretReferenceRegexp.MatchString(value) {
+			errors = append(errors, fmt.Errorf(
+				"%q must be a secret reference in the format {vault::integration_name.secret_name}", k))
+		}
+	case strings.HasPrefix(value, "crn:") && strings.Contains(value, ":secrets-manager:"):
+		if !flex.SecretsManagerSecretCRNRegexp.MatchString(value) {
+			errors = append(errors, fmt.Errorf(
+				"%q must be a Secrets Manager secret CRN in the format crn:v1:bluemix:public:secrets-manager:<region>:a/<account_id>:<instance_id>:secret:<secret_id>", k))
+		}
+	}
+	return
+}
+
 // ValidateCIDR...
 func ValidateCIDR(v interface{}, k string) (ws []string, errors []error) {
 	address := v.(string)